@@ -2,6 +2,9 @@ package linodego
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 )
 
 // AccountAvailability returns the resources availability in a region to an account.
@@ -26,3 +29,26 @@ func (c *Client) GetAccountAvailability(ctx context.Context, regionID string) (*
 	b := formatAPIPath("account/availability/%s", regionID)
 	return doGETRequest[AccountAvailability](ctx, c, b)
 }
+
+// CheckAvailable returns an error naming each of the given capabilities
+// that is unavailable to the account in this region, or nil if all of them
+// may be used. This allows callers to pre-validate a region before creating
+// resources in it.
+func (a AccountAvailability) CheckAvailable(capabilities ...string) error {
+	var unavailable []string
+
+	for _, c := range capabilities {
+		if slices.Contains(a.Unavailable, c) {
+			unavailable = append(unavailable, c)
+		}
+	}
+
+	if len(unavailable) > 0 {
+		return fmt.Errorf(
+			"region %s does not offer the following to this account: %s",
+			a.Region, strings.Join(unavailable, ", "),
+		)
+	}
+
+	return nil
+}
@@ -64,3 +64,19 @@ func (c *Client) GetAccountBetaProgram(ctx context.Context, betaID string) (*Acc
 func (c *Client) JoinBetaProgram(ctx context.Context, opts AccountBetaProgramCreateOpts) (*AccountBetaProgram, error) {
 	return doPOSTRequest[AccountBetaProgram](ctx, c, "account/betas", opts)
 }
+
+// EnsureBetaProgramJoined enrolls an account into a beta program if it is not already enrolled.
+// The existing enrollment is returned when the account has already joined the beta program,
+// which allows enrollment to be safely repeated across many accounts.
+func (c *Client) EnsureBetaProgramJoined(ctx context.Context, betaID string) (*AccountBetaProgram, error) {
+	beta, err := c.GetAccountBetaProgram(ctx, betaID)
+	if err == nil {
+		return beta, nil
+	}
+
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	return c.JoinBetaProgram(ctx, AccountBetaProgramCreateOpts{ID: betaID})
+}
@@ -123,6 +123,7 @@ const (
 	ActionLinodeMutateCreate                      EventAction = "linode_mutate_create"
 	ActionLinodeReboot                            EventAction = "linode_reboot"
 	ActionLinodeRebuild                           EventAction = "linode_rebuild"
+	ActionLinodeRescue                            EventAction = "linode_rescue"
 	ActionLinodeResize                            EventAction = "linode_resize"
 	ActionLinodeResizeCreate                      EventAction = "linode_resize_create"
 	ActionLinodeShutdown                          EventAction = "linode_shutdown"
@@ -312,12 +313,22 @@ func (c *Client) GetEvent(ctx context.Context, eventID int) (*Event, error) {
 
 // MarkEventRead marks a single Event as read.
 func (c *Client) MarkEventRead(ctx context.Context, event *Event) error {
-	e := formatAPIPath("account/events/%d/read", event.ID)
+	return c.MarkEventReadByID(ctx, event.ID)
+}
+
+// MarkEventReadByID marks the Event with the given ID as read.
+func (c *Client) MarkEventReadByID(ctx context.Context, eventID int) error {
+	e := formatAPIPath("account/events/%d/read", eventID)
 	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
 }
 
 // MarkEventsSeen marks all Events up to and including this Event by ID as seen.
 func (c *Client) MarkEventsSeen(ctx context.Context, event *Event) error {
-	e := formatAPIPath("account/events/%d/seen", event.ID)
+	return c.MarkEventsSeenUpToID(ctx, event.ID)
+}
+
+// MarkEventsSeenUpToID marks all Events up to and including the Event with the given ID as seen.
+func (c *Client) MarkEventsSeenUpToID(ctx context.Context, eventID int) error {
+	e := formatAPIPath("account/events/%d/seen", eventID)
 	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
 }
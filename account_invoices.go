@@ -3,12 +3,19 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
 // Invoice structs reflect an invoice for billable activity on the account.
+//
+// Total, Tax and Subtotal are float32 approximations of the amounts returned by the API.
+// For accounting purposes use TotalCents, TaxCents and SubtotalCents, which are parsed
+// directly from the API's decimal representation and are not subject to rounding drift.
 type Invoice struct {
 	ID            int                 `json:"id"`
 	Label         string              `json:"label"`
@@ -18,6 +25,10 @@ type Invoice struct {
 	Subtotal      float32             `json:"subtotal"`
 	BillingSource string              `json:"billing_source"`
 	TaxSummary    []InvoiceTaxSummary `json:"tax_summary"`
+
+	TotalCents    int64 `json:"-"`
+	TaxCents      int64 `json:"-"`
+	SubtotalCents int64 `json:"-"`
 }
 
 type InvoiceTaxSummary struct {
@@ -26,6 +37,10 @@ type InvoiceTaxSummary struct {
 }
 
 // InvoiceItem structs reflect a single billable activity associate with an Invoice
+//
+// As with Invoice, AmountCents, TaxCents and TotalCents hold the exact values of
+// Amount, Tax and Total in cents. UnitPrice may carry sub-cent precision and is
+// only available as a float32.
 type InvoiceItem struct {
 	Label     string     `json:"label"`
 	Type      string     `json:"type"`
@@ -37,9 +52,15 @@ type InvoiceItem struct {
 	From      *time.Time `json:"-"`
 	To        *time.Time `json:"-"`
 	Total     float32    `json:"total"`
+
+	AmountCents int64 `json:"-"`
+	TaxCents    int64 `json:"-"`
+	TotalCents  int64 `json:"-"`
 }
 
-// ListInvoices gets a paginated list of Invoices against the Account
+// ListInvoices gets a paginated list of Invoices against the Account. Invoices within a
+// date range can be selected with a Filter, e.g. And("", "", &Comp{"date", Gte, since}, &Comp{"date", Lt, until}),
+// where since and until are formatted as "2006-01-02T15:04:05" in UTC.
 func (c *Client) ListInvoices(ctx context.Context, opts *ListOptions) ([]Invoice, error) {
 	return getPaginatedResults[Invoice](ctx, c, "account/invoices", opts)
 }
@@ -50,7 +71,10 @@ func (i *Invoice) UnmarshalJSON(b []byte) error {
 
 	p := struct {
 		*Mask
-		Date *parseabletime.ParseableTime `json:"date"`
+		Date     *parseabletime.ParseableTime `json:"date"`
+		Total    json.Number                  `json:"total"`
+		Tax      json.Number                  `json:"tax"`
+		Subtotal json.Number                  `json:"subtotal"`
 	}{
 		Mask: (*Mask)(i),
 	}
@@ -61,6 +85,20 @@ func (i *Invoice) UnmarshalJSON(b []byte) error {
 
 	i.Date = (*time.Time)(p.Date)
 
+	var err error
+
+	if i.Total, i.TotalCents, err = parseInvoiceAmount(p.Total); err != nil {
+		return err
+	}
+
+	if i.Tax, i.TaxCents, err = parseInvoiceAmount(p.Tax); err != nil {
+		return err
+	}
+
+	if i.Subtotal, i.SubtotalCents, err = parseInvoiceAmount(p.Subtotal); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -70,8 +108,11 @@ func (i *InvoiceItem) UnmarshalJSON(b []byte) error {
 
 	p := struct {
 		*Mask
-		From *parseabletime.ParseableTime `json:"from"`
-		To   *parseabletime.ParseableTime `json:"to"`
+		From   *parseabletime.ParseableTime `json:"from"`
+		To     *parseabletime.ParseableTime `json:"to"`
+		Amount json.Number                  `json:"amount"`
+		Tax    json.Number                  `json:"tax"`
+		Total  json.Number                  `json:"total"`
 	}{
 		Mask: (*Mask)(i),
 	}
@@ -83,9 +124,61 @@ func (i *InvoiceItem) UnmarshalJSON(b []byte) error {
 	i.From = (*time.Time)(p.From)
 	i.To = (*time.Time)(p.To)
 
+	var err error
+
+	if i.Amount, i.AmountCents, err = parseInvoiceAmount(p.Amount); err != nil {
+		return err
+	}
+
+	if i.Tax, i.TaxCents, err = parseInvoiceAmount(p.Tax); err != nil {
+		return err
+	}
+
+	if i.Total, i.TotalCents, err = parseInvoiceAmount(p.Total); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// parseInvoiceAmount parses a dollar amount, in decimal or exponent form, into both
+// its float32 approximation and its exact value in cents. Fractions of a cent are
+// rounded half away from zero.
+func parseInvoiceAmount(n json.Number) (float32, int64, error) {
+	if n == "" {
+		return 0, 0, nil
+	}
+
+	f, err := strconv.ParseFloat(n.String(), 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid amount %q: %w", n, err)
+	}
+
+	amount, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid amount %q", n)
+	}
+
+	amount.Mul(amount, big.NewRat(100, 1))
+
+	num := new(big.Int).Abs(amount.Num())
+	cents, rem := num.QuoRem(num, amount.Denom(), new(big.Int))
+
+	if rem.Lsh(rem, 1).Cmp(amount.Denom()) >= 0 {
+		cents.Add(cents, big.NewInt(1))
+	}
+
+	if !cents.IsInt64() {
+		return 0, 0, fmt.Errorf("invalid amount %q: out of range", n)
+	}
+
+	if amount.Sign() < 0 {
+		cents.Neg(cents)
+	}
+
+	return float32(f), cents.Int64(), nil
+}
+
 // GetInvoice gets a single Invoice matching the provided ID
 func (c *Client) GetInvoice(ctx context.Context, invoiceID int) (*Invoice, error) {
 	e := formatAPIPath("account/invoices/%d", invoiceID)
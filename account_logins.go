@@ -8,6 +8,7 @@ import (
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// Login represents a login attempt on the account
 type Login struct {
 	ID         int        `json:"id"`
 	Datetime   *time.Time `json:"datetime"`
@@ -17,10 +18,25 @@ type Login struct {
 	Status     string     `json:"status"`
 }
 
+// ListLogins lists the account's logins
 func (c *Client) ListLogins(ctx context.Context, opts *ListOptions) ([]Login, error) {
 	return getPaginatedResults[Login](ctx, c, "account/logins", opts)
 }
 
+// ListLoginsBetween lists the account's logins at or after start and before end,
+// ordered by the time of the login.
+func (c *Client) ListLoginsBetween(ctx context.Context, start, end time.Time) ([]Login, error) {
+	f, err := And(Ascending, "datetime",
+		&Comp{"datetime", Gte, start.UTC().Format("2006-01-02T15:04:05")},
+		&Comp{"datetime", Lt, end.UTC().Format("2006-01-02T15:04:05")},
+	).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListLogins(ctx, NewListOptions(0, f))
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *Login) UnmarshalJSON(b []byte) error {
 	type Mask Login
@@ -41,6 +57,7 @@ func (i *Login) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// GetLogin gets the login with the provided ID
 func (c *Client) GetLogin(ctx context.Context, loginID int) (*Login, error) {
 	e := formatAPIPath("account/logins/%d", loginID)
 	return doGETRequest[Login](ctx, c, e)
@@ -49,3 +49,17 @@ func (accountMaintenance *AccountMaintenance) UnmarshalJSON(b []byte) error {
 func (c *Client) ListMaintenances(ctx context.Context, opts *ListOptions) ([]AccountMaintenance, error) {
 	return getPaginatedResults[AccountMaintenance](ctx, c, "account/maintenance", opts)
 }
+
+// ListMaintenancesBetween lists Account Maintenance objects scheduled at or after start and before end,
+// ordered by when the maintenance is scheduled to begin.
+func (c *Client) ListMaintenancesBetween(ctx context.Context, start, end time.Time) ([]AccountMaintenance, error) {
+	f, err := And(Ascending, "when",
+		&Comp{"when", Gte, start.UTC().Format("2006-01-02T15:04:05")},
+		&Comp{"when", Lt, end.UTC().Format("2006-01-02T15:04:05")},
+	).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListMaintenances(ctx, NewListOptions(0, f))
+}
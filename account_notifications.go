@@ -56,6 +56,21 @@ const (
 	NotificationMaintenance        NotificationType = "maintenance"
 )
 
+// IsMaintenance returns whether the Notification is about maintenance that is scheduled or in progress,
+// including scheduled migrations and reboots.
+func (n Notification) IsMaintenance() bool {
+	switch n.Type {
+	case NotificationMaintenance,
+		NotificationMigrationScheduled,
+		NotificationMigrationImminent,
+		NotificationMigrationPending,
+		NotificationRebootScheduled:
+		return true
+	default:
+		return false
+	}
+}
+
 // ListNotifications gets a collection of Notification objects representing important,
 // often time-sensitive items related to the Account. An account cannot interact directly with
 // Notifications, and a Notification will disappear when the circumstances causing it
@@ -91,7 +91,8 @@ func (c *Client) GetOAuthClient(ctx context.Context, clientID string) (*OAuthCli
 	return doGETRequest[OAuthClient](ctx, c, e)
 }
 
-// CreateOAuthClient creates an OAuthClient
+// CreateOAuthClient creates an OAuthClient. The returned OAuthClient's Secret is
+// only populated in this response; store it immediately, as later reads return it redacted.
 func (c *Client) CreateOAuthClient(ctx context.Context, opts OAuthClientCreateOptions) (*OAuthClient, error) {
 	return doPOSTRequest[OAuthClient](ctx, c, "account/oauth-clients", opts)
 }
@@ -108,7 +109,8 @@ func (c *Client) DeleteOAuthClient(ctx context.Context, clientID string) error {
 	return doDELETERequest(ctx, c, e)
 }
 
-// ResetOAuthClientSecret resets the OAuth Client secret for a client with a specified id
+// ResetOAuthClientSecret resets the OAuth Client secret for a client with a specified id.
+// The new Secret is only populated in the returned OAuthClient.
 func (c *Client) ResetOAuthClientSecret(ctx context.Context, clientID string) (*OAuthClient, error) {
 	e := formatAPIPath("account/oauth-clients/%s/reset-secret", clientID)
 	return doPOSTRequest[OAuthClient, any](ctx, c, e)
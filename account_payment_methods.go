@@ -9,7 +9,18 @@ import (
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
-// PaymentMethod represents a PaymentMethod object
+// PaymentMethodType constants start with PaymentMethodType and include Linode API Payment Method types
+type PaymentMethodType string
+
+// PaymentMethodType constants represent the kinds of Payment Methods that may be on file for an Account
+const (
+	PaymentMethodTypeCreditCard PaymentMethodType = "credit_card"
+	PaymentMethodTypeGooglePay  PaymentMethodType = "google_pay"
+	PaymentMethodTypePaypal     PaymentMethodType = "paypal"
+)
+
+// PaymentMethod represents a PaymentMethod object.
+// Card details are masked by the API; only the card type, expiry and last four digits are returned.
 type PaymentMethod struct {
 	// The unique ID of the Payment Method.
 	ID int `json:"id"`
@@ -20,10 +31,11 @@ type PaymentMethod struct {
 	// Whether this Payment Method is the default method for automatically processing service charges.
 	IsDefault bool `json:"is_default"`
 
-	// The type of Payment Method.
+	// The type of Payment Method. See the PaymentMethodType constants for known values.
 	Type string `json:"type"`
 
-	// The detailed data for the Payment Method, which can be of varying types.
+	// The detailed data for the Payment Method, which can be of varying types:
+	// PaymentMethodDataCreditCard, PaymentMethodDataGooglePay or PaymentMethodDataPaypal.
 	Data interface{} `json:"data"`
 }
 
@@ -107,20 +119,20 @@ func (i *PaymentMethod) UnmarshalJSON(b []byte) error {
 	}
 
 	// Process Data based on the Type field
-	switch i.Type {
-	case "credit_card":
+	switch PaymentMethodType(i.Type) {
+	case PaymentMethodTypeCreditCard:
 		var creditCardData PaymentMethodDataCreditCard
 		if err := json.Unmarshal(pm.Data, &creditCardData); err != nil {
 			return err
 		}
 		i.Data = creditCardData
-	case "google_pay":
+	case PaymentMethodTypeGooglePay:
 		var googlePayData PaymentMethodDataGooglePay
 		if err := json.Unmarshal(pm.Data, &googlePayData); err != nil {
 			return err
 		}
 		i.Data = googlePayData
-	case "paypal":
+	case PaymentMethodTypePaypal:
 		var paypalData PaymentMethodDataPaypal
 		if err := json.Unmarshal(pm.Data, &paypalData); err != nil {
 			return err
@@ -158,6 +170,6 @@ func (c *Client) AddPaymentMethod(ctx context.Context, opts PaymentMethodCreateO
 
 // SetDefaultPaymentMethod sets the payment method with the provided ID as the default
 func (c *Client) SetDefaultPaymentMethod(ctx context.Context, paymentMethodID int) error {
-	e := formatAPIPath("account/payment-methods/%d", paymentMethodID)
+	e := formatAPIPath("account/payment-methods/%d/make-default", paymentMethodID)
 	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
 }
@@ -68,6 +68,13 @@ func (ast *AccountServiceTransfer) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Expired returns true if the transfer token's Expiry has passed. A pending transfer
+// that is not accepted before it expires can no longer be accepted, and a new
+// transfer must be requested with RequestAccountServiceTransfer.
+func (ast AccountServiceTransfer) Expired() bool {
+	return ast.Expiry != nil && !time.Now().Before(*ast.Expiry)
+}
+
 // ListAccountServiceTransfer gets a paginated list of AccountServiceTransfer for the Account.
 func (c *Client) ListAccountServiceTransfer(ctx context.Context, opts *ListOptions) ([]AccountServiceTransfer, error) {
 	return getPaginatedResults[AccountServiceTransfer](ctx, c, "account/service-transfers", opts)
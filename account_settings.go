@@ -9,10 +9,10 @@ type AccountSettings struct {
 	// The default backups enrollment status for all new Linodes for all users on the account.  When enabled, backups are mandatory per instance.
 	BackupsEnabled bool `json:"backups_enabled"`
 
-	// Wether or not Linode Managed service is enabled for the account.
+	// Whether or not Linode Managed service is enabled for the account.
 	Managed bool `json:"managed"`
 
-	// Wether or not the Network Helper is enabled for all new Linode Instance Configs on the account.
+	// Whether or not the Network Helper is enabled for all new Linode Instance Configs on the account.
 	NetworkHelper bool `json:"network_helper"`
 
 	// A plan name like "longview-3"..."longview-100", or a nil value for to cancel any existing subscription plan.
@@ -35,12 +35,20 @@ type AccountSettingsUpdateOptions struct {
 	NetworkHelper *bool `json:"network_helper,omitempty"`
 }
 
+// GetUpdateOptions converts AccountSettings to AccountSettingsUpdateOptions for use in UpdateAccountSettings
+func (i AccountSettings) GetUpdateOptions() (o AccountSettingsUpdateOptions) {
+	o.BackupsEnabled = copyBool(&i.BackupsEnabled)
+	o.NetworkHelper = copyBool(&i.NetworkHelper)
+
+	return
+}
+
 // GetAccountSettings gets the account wide flags or plans that effect new resources
 func (c *Client) GetAccountSettings(ctx context.Context) (*AccountSettings, error) {
 	return doGETRequest[AccountSettings](ctx, c, "account/settings")
 }
 
-// UpdateAccountSettings updates the settings associated with the account
+// UpdateAccountSettings updates the settings associated with the account and returns the effective settings
 func (c *Client) UpdateAccountSettings(ctx context.Context, opts AccountSettingsUpdateOptions) (*AccountSettings, error) {
 	return doPUTRequest[AccountSettings](ctx, c, "account/settings", opts)
 }
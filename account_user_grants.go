@@ -72,6 +72,44 @@ type UserGrantsUpdateOptions struct {
 	Global GlobalUserGrants `json:"global"`
 }
 
+// GetUpdateOptions converts UserGrants to UserGrantsUpdateOptions for use in UpdateUserGrants,
+// allowing a user's current grants to be retrieved, modified and written back.
+func (g UserGrants) GetUpdateOptions() UserGrantsUpdateOptions {
+	return UserGrantsUpdateOptions{
+		Database:       g.Database,
+		Domain:         entityUserGrants(g.Domain),
+		Firewall:       entityUserGrants(g.Firewall),
+		Image:          entityUserGrants(g.Image),
+		Linode:         entityUserGrants(g.Linode),
+		Longview:       entityUserGrants(g.Longview),
+		NodeBalancer:   entityUserGrants(g.NodeBalancer),
+		PlacementGroup: entityUserGrants(g.PlacementGroup),
+		StackScript:    entityUserGrants(g.StackScript),
+		Volume:         entityUserGrants(g.Volume),
+		VPC:            entityUserGrants(g.VPC),
+
+		Global: g.Global,
+	}
+}
+
+func entityUserGrants(entities []GrantedEntity) []EntityUserGrant {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]EntityUserGrant, len(entities))
+
+	for i, entity := range entities {
+		result[i] = EntityUserGrant{ID: entity.ID}
+
+		if entity.Permissions != "" {
+			result[i].Permissions = Pointer(entity.Permissions)
+		}
+	}
+
+	return result
+}
+
 func (c *Client) GetUserGrants(ctx context.Context, username string) (*UserGrants, error) {
 	e := formatAPIPath("account/users/%s/grants", username)
 	return doGETRequest[UserGrants](ctx, c, e)
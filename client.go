@@ -3,10 +3,13 @@ package linodego
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
@@ -14,6 +17,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -48,6 +52,8 @@ const (
 	// Maximum wait time for retries
 	APIRetryMaxWaitTime       = time.Duration(30) * time.Second
 	APIDefaultCacheExpiration = time.Minute * 15
+	// APIDefaultStreamConcurrency is the default number of pages StreamAll requests at once
+	APIDefaultStreamConcurrency = 4
 )
 
 //nolint:unused
@@ -73,8 +79,16 @@ type Client struct {
 	debug             bool
 	retryConditionals []RetryConditional
 
+	retryClassifier     RetryClassifier
+	retryClassifierLock *sync.RWMutex
+
+	requestMetricsHook *requestMetricsHook
+	requestLogger      *requestLogger
+
 	pollInterval time.Duration
 
+	streamConcurrency int
+
 	baseURL         string
 	apiVersion      string
 	apiProto        string
@@ -88,6 +102,10 @@ type Client struct {
 	cacheExpiration time.Duration
 	cachedEntries   map[string]clientCacheEntry
 	cachedEntryLock *sync.RWMutex
+
+	// endpointCacheTTLs holds the cache expiration of endpoints configured
+	// with SetEndpointCache, keyed by endpoint path.
+	endpointCacheTTLs map[string]time.Duration
 }
 
 type EnvDefaults struct {
@@ -98,6 +116,9 @@ type EnvDefaults struct {
 type clientCacheEntry struct {
 	Created time.Time
 	Data    any
+	// The path of the endpoint this entry was cached for,
+	// used to invalidate every entry of an endpoint
+	Endpoint string
 	// If != nil, use this instead of the
 	// global expiry
 	ExpiryOverride *time.Duration
@@ -131,8 +152,19 @@ func NewClient(hc *http.Client) (client Client) {
 
 	client.shouldCache = true
 	client.cacheExpiration = APIDefaultCacheExpiration
+	client.streamConcurrency = APIDefaultStreamConcurrency
 	client.cachedEntries = make(map[string]clientCacheEntry)
 	client.cachedEntryLock = &sync.RWMutex{}
+	client.endpointCacheTTLs = make(map[string]time.Duration)
+	client.retryClassifierLock = &sync.RWMutex{}
+
+	client.requestMetricsHook = &requestMetricsHook{}
+	client.requestLogger = &requestLogger{}
+	registerRequestObservers(client.resty, client.requestMetricsHook, client.requestLogger)
+	client.resty.OnBeforeRequest(applyIdempotencyKey)
+	client.resty.OnSuccess(func(_ *resty.Client, resp *resty.Response) { releaseRequestTimeout(resp.Request) })
+	client.resty.OnError(func(r *resty.Request, _ error) { releaseRequestTimeout(r) })
+	client.resty.OnInvalid(func(r *resty.Request, _ error) { releaseRequestTimeout(r) })
 
 	client.SetUserAgent(DefaultUserAgent)
 
@@ -222,6 +254,25 @@ func (c *Client) SetUserAgent(ua string) *Client {
 	return c
 }
 
+// AppendUserAgent appends the given component to the client's current
+// user-agent, separated by a space. This preserves the linodego version in the
+// user-agent while identifying the calling application.
+// For example:
+//
+//	client.AppendUserAgent("my-tool/1.2.3")
+func (c *Client) AppendUserAgent(component string) *Client {
+	component = strings.TrimSpace(component)
+	if component == "" {
+		return c
+	}
+
+	if c.userAgent == "" {
+		return c.SetUserAgent(component)
+	}
+
+	return c.SetUserAgent(c.userAgent + " " + component)
+}
+
 type RequestParams struct {
 	Body     any
 	Response any
@@ -454,13 +505,15 @@ func (c *httpClient) decodeResponseBody(resp *http.Response, response interface{
 	return nil
 }
 
-// R wraps resty's R method
+// R wraps resty's R method, applying any RequestOptions attached to ctx
 func (c *Client) R(ctx context.Context) *resty.Request {
-	return c.resty.R().
+	req := c.resty.R().
 		ExpectContentType("application/json").
 		SetHeader("Content-Type", "application/json").
 		SetContext(ctx).
 		SetError(APIError{})
+
+	return applyRequestOptions(ctx, req)
 }
 
 // SetDebug sets the debug on resty's client
@@ -606,6 +659,26 @@ func (c *Client) AddRetryCondition(retryCondition RetryConditional) *Client {
 	return c
 }
 
+// SetRetryClassifier sets a function used to decide whether a failed request
+// should be retried. When set, the classifier overrides the default retry
+// conditions configured by SetRetries. Passing nil restores the default behavior.
+// The retry wait time is still determined by the existing backoff logic.
+func (c *Client) SetRetryClassifier(classifier RetryClassifier) *Client {
+	c.retryClassifierLock.Lock()
+	defer c.retryClassifierLock.Unlock()
+
+	c.retryClassifier = classifier
+
+	return c
+}
+
+func (c *Client) getRetryClassifier() RetryClassifier {
+	c.retryClassifierLock.RLock()
+	defer c.retryClassifierLock.RUnlock()
+
+	return c.retryClassifier
+}
+
 // InvalidateCache clears all cached responses for all endpoints.
 func (c *Client) InvalidateCache() {
 	c.cachedEntryLock.Lock()
@@ -615,29 +688,111 @@ func (c *Client) InvalidateCache() {
 	c.cachedEntries = make(map[string]clientCacheEntry)
 }
 
-// InvalidateCacheEndpoint invalidates a single cached endpoint.
+// InvalidateCacheEndpoint invalidates a single cached endpoint,
+// including responses cached for each of its ListOptions and request headers.
 func (c *Client) InvalidateCacheEndpoint(endpoint string) error {
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to parse URL for caching: %w", err)
 	}
 
+	path := strings.Trim(u.Path, "/")
+
 	c.cachedEntryLock.Lock()
 	defer c.cachedEntryLock.Unlock()
 
-	delete(c.cachedEntries, u.Path)
+	maps.DeleteFunc(c.cachedEntries, func(_ string, entry clientCacheEntry) bool {
+		return entry.Endpoint == path
+	})
 
 	return nil
 }
 
+// invalidateCachedEndpoints invalidates the cached responses of endpoint and its sub-paths.
+func (c *Client) invalidateCachedEndpoints(endpoint string) {
+	c.cachedEntryLock.Lock()
+	defer c.cachedEntryLock.Unlock()
+
+	maps.DeleteFunc(c.cachedEntries, func(_ string, entry clientCacheEntry) bool {
+		return endpointHasPrefix(entry.Endpoint, endpoint)
+	})
+}
+
+// DefaultCachedEndpoints are the endpoints cached by SetEndpointCache when no endpoints are given.
+var DefaultCachedEndpoints = []string{"regions", "linode/types", "linode/kernels", "images"}
+
+// SetEndpointCache caches the responses of the given endpoints and their sub-paths for ttl,
+// in place of the global cache expiration. If no endpoints are given, DefaultCachedEndpoints are used.
+// A ttl less than or equal to zero disables caching for the given endpoints.
+//
+// Images are only cached once enabled with SetEndpointCache, as private images change
+// while they are being created; they are invalidated when an Image is modified with this Client.
+// Caching must not have been disabled with UseCache.
+func (c *Client) SetEndpointCache(ttl time.Duration, endpoints ...string) {
+	if len(endpoints) == 0 {
+		endpoints = DefaultCachedEndpoints
+	}
+
+	c.cachedEntryLock.Lock()
+	defer c.cachedEntryLock.Unlock()
+
+	for _, endpoint := range endpoints {
+		endpoint = strings.Trim(endpoint, "/")
+
+		c.endpointCacheTTLs[endpoint] = ttl
+
+		if ttl <= 0 {
+			maps.DeleteFunc(c.cachedEntries, func(_ string, entry clientCacheEntry) bool {
+				return endpointHasPrefix(entry.Endpoint, endpoint)
+			})
+		}
+	}
+}
+
+// endpointCacheTTL returns the cache expiration configured with SetEndpointCache for
+// the given endpoint path, using the most specific configured endpoint.
+// The caller must hold cachedEntryLock.
+func (c *Client) endpointCacheTTL(path string) (time.Duration, bool) {
+	var (
+		ttl     time.Duration
+		matched string
+		found   bool
+	)
+
+	for endpoint, endpointTTL := range c.endpointCacheTTLs {
+		if endpointHasPrefix(path, endpoint) && (!found || len(endpoint) > len(matched)) {
+			ttl, matched, found = endpointTTL, endpoint, true
+		}
+	}
+
+	return ttl, found
+}
+
+// isEndpointCacheEnabled returns whether caching of the given endpoint
+// has been enabled with SetEndpointCache.
+func (c *Client) isEndpointCacheEnabled(endpoint string) bool {
+	c.cachedEntryLock.RLock()
+	defer c.cachedEntryLock.RUnlock()
+
+	ttl, ok := c.endpointCacheTTL(cacheEndpointPath(endpoint))
+
+	return c.shouldCache && ok && ttl > 0
+}
+
 // SetGlobalCacheExpiration sets the desired time for any cached response
 // to be valid for.
 func (c *Client) SetGlobalCacheExpiration(expiryTime time.Duration) {
+	c.cachedEntryLock.Lock()
+	defer c.cachedEntryLock.Unlock()
+
 	c.cacheExpiration = expiryTime
 }
 
 // UseCache sets whether response caching should be used
 func (c *Client) UseCache(value bool) {
+	c.cachedEntryLock.Lock()
+	defer c.cachedEntryLock.Unlock()
+
 	c.shouldCache = value
 }
 
@@ -660,6 +815,24 @@ func (c *Client) SetRetryAfter(callback RetryAfter) *Client {
 	return c
 }
 
+// SetRetryBackoff configures an exponential backoff between retries.
+// The delay before the nth retry is base * 2^(n-1) plus a random jitter of up
+// to jitter * delay, capped at maxWait. Jitter only lengthens the delay, so the
+// first retry never waits less than base. A jitter of 0 yields
+// deterministic delays. The Retry-After response header still takes precedence
+// when it is present.
+//
+// By default, the client waits with a randomized backoff between
+// APISecondsPerPoll seconds and APIRetryMaxWaitTime.
+func (c *Client) SetRetryBackoff(base, maxWait time.Duration, jitter float64) *Client {
+	c.resty.
+		SetRetryWaitTime(base).
+		SetRetryMaxWaitTime(maxWait).
+		SetRetryAfter(resty.RetryAfterFunc(retryBackoff(base, maxWait, jitter)))
+
+	return c
+}
+
 // SetRetryCount sets the maximum retry attempts before aborting.
 func (c *Client) SetRetryCount(count int) *Client {
 	c.resty.SetRetryCount(count)
@@ -679,6 +852,19 @@ func (c *Client) GetPollDelay() time.Duration {
 	return c.pollInterval
 }
 
+// SetStreamConcurrency sets the maximum number of pages StreamAll and the Stream* functions
+// request at once. Values less than one are treated as one.
+func (c *Client) SetStreamConcurrency(concurrency int) *Client {
+	c.streamConcurrency = concurrency
+	return c
+}
+
+// GetStreamConcurrency gets the maximum number of pages StreamAll and the Stream* functions
+// request at once.
+func (c *Client) GetStreamConcurrency() int {
+	return max(c.streamConcurrency, 1)
+}
+
 // SetHeader sets a custom header to be used in all API requests made with the current
 // client.
 // NOTE: Some headers may be overridden by the individual request functions.
@@ -686,21 +872,154 @@ func (c *Client) SetHeader(name, value string) {
 	c.resty.SetHeader(name, value)
 }
 
-func (c *Client) addRetryConditional(retryConditional RetryConditional) *Client {
-	c.retryConditionals = append(c.retryConditionals, retryConditional)
+// SetCompressionEnabled sets whether the client requests gzip or deflate
+// encoded responses from the API. When enabled, the Accept-Encoding header is
+// set on all requests and response bodies are decoded before they are parsed,
+// so error handling and streaming responses work as they do uncompressed.
+//
+// NOTE: This wraps the transport of the underlying HTTP client, so it should be
+// called after SetRootCertificate.
+func (c *Client) SetCompressionEnabled(enabled bool) *Client {
+	hc := c.resty.GetClient()
+
+	if !enabled {
+		hc.Transport = removeTransportWrapper[*decompressingTransport](hc.Transport)
+		c.resty.Header.Del("Accept-Encoding")
+
+		return c
+	}
+
+	if !hasTransportWrapper[*decompressingTransport](hc.Transport) {
+		hc.Transport = &decompressingTransport{base: transportOrDefault(hc.Transport)}
+	}
+
+	c.resty.SetHeader("Accept-Encoding", acceptEncodingHeaderValue)
+
 	return c
 }
 
-func (c *Client) addCachedResponse(endpoint string, response any, expiry *time.Duration) {
-	if !c.shouldCache {
-		return
+// SetTracerProvider sets the TracerProvider used to trace API requests.
+// Each request sent to the API, including retries, is recorded as a Span named
+// by the request method and path, with the response status code and
+// X-Linode-Request-ID as attributes. Passing nil disables tracing.
+//
+// NOTE: This wraps the transport of the underlying HTTP client, so it should be
+// called after SetRootCertificate.
+func (c *Client) SetTracerProvider(tp TracerProvider) *Client {
+	hc := c.resty.GetClient()
+	hc.Transport = removeTransportWrapper[*tracingTransport](hc.Transport)
+
+	if tp == nil {
+		return c
 	}
 
+	hc.Transport = &tracingTransport{
+		base:   transportOrDefault(hc.Transport),
+		tracer: tp.Tracer(tracerInstrumentationName),
+	}
+
+	return c
+}
+
+// transportWrapper is implemented by the http.RoundTripper wrappers installed by
+// the Client, so that each can be found in the transport chain regardless of the
+// order they were installed in.
+type transportWrapper interface {
+	http.RoundTripper
+	baseTransport() http.RoundTripper
+	withBaseTransport(base http.RoundTripper) http.RoundTripper
+}
+
+func transportOrDefault(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		return http.DefaultTransport
+	}
+
+	return rt
+}
+
+// hasTransportWrapper returns whether a wrapper of type T is in the chain of rt.
+func hasTransportWrapper[T transportWrapper](rt http.RoundTripper) bool {
+	for rt != nil {
+		if _, ok := rt.(T); ok {
+			return true
+		}
+
+		w, ok := rt.(transportWrapper)
+		if !ok {
+			return false
+		}
+
+		rt = w.baseTransport()
+	}
+
+	return false
+}
+
+// removeTransportWrapper returns rt with any wrappers of type T removed from its chain.
+// The wrappers above a removed one are copied rather than modified, as they may be
+// in use by in-flight requests.
+func removeTransportWrapper[T transportWrapper](rt http.RoundTripper) http.RoundTripper {
+	if !hasTransportWrapper[T](rt) {
+		return rt
+	}
+
+	w := rt.(transportWrapper)
+	base := removeTransportWrapper[T](w.baseTransport())
+
+	if _, ok := rt.(T); ok {
+		return base
+	}
+
+	return w.withBaseTransport(base)
+}
+
+// SetRequestMetricsHook sets a function to be called after each API request
+// completes, including requests that fail, with information about the request.
+// This can be used to record request counts and latencies, e.g. in Prometheus.
+// Passing nil removes the hook.
+//
+// NOTE: The hook runs synchronously on the request path, so it should return quickly.
+func (c *Client) SetRequestMetricsHook(hook func(info RequestMetrics)) *Client {
+	c.requestMetricsHook.set(hook)
+	return c
+}
+
+// SetStructuredLogger sets a logger that receives one entry for each API request,
+// with its method, path, status, duration and request ID. Successful requests are
+// logged at info level, API error responses at warn level and failed requests at
+// error level. Unlike SetDebug, bodies are only logged when enabled with SetLogBodies,
+// in a separate entry at debug level.
+// Passing nil disables structured logging.
+//
+// NOTE: This is not named SetLogger because SetLogger already sets the Logger
+// used by resty's debug output.
+func (c *Client) SetStructuredLogger(logger StructuredLogger) *Client {
+	c.requestLogger.setLogger(logger)
+	return c
+}
+
+// SetLogBodies enables logging of request and response bodies with SetStructuredLogger.
+// Each body is passed through redactor before it is logged, so that secrets such as tokens
+// and passwords can be removed. RedactSensitiveFields can be used as a default.
+// Passing nil disables body logging.
+func (c *Client) SetLogBodies(redactor LogRedactor) *Client {
+	c.requestLogger.setRedactor(redactor)
+	return c
+}
+
+func (c *Client) addRetryConditional(retryConditional RetryConditional) *Client {
+	c.retryConditionals = append(c.retryConditionals, retryConditional)
+	return c
+}
+
+func (c *Client) addCachedResponse(ctx context.Context, endpoint string, response any, expiry *time.Duration) {
 	responseValue := reflect.ValueOf(response)
 
 	entry := clientCacheEntry{
 		Created:        time.Now(),
 		ExpiryOverride: expiry,
+		Endpoint:       cacheEndpointPath(endpoint),
 	}
 
 	switch responseValue.Kind() {
@@ -712,17 +1031,25 @@ func (c *Client) addCachedResponse(endpoint string, response any, expiry *time.D
 		entry.Data = response
 	}
 
+	key := c.cacheKey(ctx, endpoint)
+
 	c.cachedEntryLock.Lock()
 	defer c.cachedEntryLock.Unlock()
 
-	c.cachedEntries[endpoint] = entry
-}
-
-func (c *Client) getCachedResponse(endpoint string) any {
 	if !c.shouldCache {
-		return nil
+		return
 	}
 
+	if ttl, ok := c.endpointCacheTTL(entry.Endpoint); ok && ttl <= 0 {
+		return
+	}
+
+	c.cachedEntries[key] = entry
+}
+
+func (c *Client) getCachedResponse(ctx context.Context, endpoint string) any {
+	key := c.cacheKey(ctx, endpoint)
+
 	c.cachedEntryLock.RLock()
 
 	// Hacky logic to dynamically RUnlock
@@ -737,7 +1064,11 @@ func (c *Client) getCachedResponse(endpoint string) any {
 		}
 	}()
 
-	entry, ok := c.cachedEntries[endpoint]
+	if !c.shouldCache {
+		return nil
+	}
+
+	entry, ok := c.cachedEntries[key]
 	if !ok {
 		return nil
 	}
@@ -746,7 +1077,9 @@ func (c *Client) getCachedResponse(endpoint string) any {
 	elapsedTime := time.Since(entry.Created)
 
 	hasExpired := elapsedTime > c.cacheExpiration
-	if entry.ExpiryOverride != nil {
+	if ttl, ok := c.endpointCacheTTL(entry.Endpoint); ok {
+		hasExpired = elapsedTime > ttl
+	} else if entry.ExpiryOverride != nil {
 		hasExpired = elapsedTime > *entry.ExpiryOverride
 	}
 
@@ -758,11 +1091,42 @@ func (c *Client) getCachedResponse(endpoint string) any {
 		c.cachedEntryLock.Lock()
 		defer c.cachedEntryLock.Unlock()
 
-		delete(c.cachedEntries, endpoint)
+		delete(c.cachedEntries, key)
 		return nil
 	}
 
-	return c.cachedEntries[endpoint].Data
+	return c.cachedEntries[key].Data
+}
+
+// cacheKey returns the key used to cache the response of endpoint for requests made with ctx.
+// Headers set with WithRequestOptions can change the response, so they are part of the key.
+func (c *Client) cacheKey(ctx context.Context, endpoint string) string {
+	req := applyRequestOptions(ctx, c.resty.R().SetContext(ctx))
+	releaseRequestTimeout(req)
+
+	if len(req.Header) == 0 {
+		return endpoint
+	}
+
+	h := sha256.New()
+
+	for _, name := range slices.Sorted(maps.Keys(req.Header)) {
+		fmt.Fprintf(h, "%s=%q\n", name, req.Header.Values(name))
+	}
+
+	return fmt.Sprintf("%s#%s", endpoint, hex.EncodeToString(h.Sum(nil)))
+}
+
+// cacheEndpointPath returns the path of a cached endpoint without
+// the ListOptions hash added by generateListCacheURL.
+func cacheEndpointPath(endpoint string) string {
+	path, _, _ := strings.Cut(endpoint, ":")
+	return strings.Trim(path, "/")
+}
+
+// endpointHasPrefix returns whether path is the given endpoint or one of its sub-paths.
+func endpointHasPrefix(path, endpoint string) bool {
+	return path == endpoint || strings.HasPrefix(path, endpoint+"/")
 }
 
 func (c *Client) updateHostURL() {
@@ -868,6 +1232,26 @@ func copyTime(tPtr *time.Time) *time.Time {
 	return &t
 }
 
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+
+	t := *p
+
+	return &t
+}
+
+func copySlicePtr[T any](p *[]T) *[]T {
+	if p == nil {
+		return nil
+	}
+
+	t := slices.Clone(*p)
+
+	return &t
+}
+
 func generateListCacheURL(endpoint string, opts *ListOptions) (string, error) {
 	if opts == nil {
 		return endpoint, nil
@@ -2,14 +2,17 @@ package linodego
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -115,6 +118,22 @@ func TestClient_NewFromEnvToken(t *testing.T) {
 	}
 }
 
+func TestClient_AppendUserAgent(t *testing.T) {
+	client := NewClient(nil)
+
+	client.AppendUserAgent("my-tool/1.0.0").AppendUserAgent(" my-plugin/0.1.0 ").AppendUserAgent("")
+
+	expected := DefaultUserAgent + " my-tool/1.0.0 my-plugin/0.1.0"
+
+	if client.userAgent != expected {
+		t.Fatal(cmp.Diff(client.userAgent, expected))
+	}
+
+	if client.resty.Header.Get("User-Agent") != expected {
+		t.Fatal(cmp.Diff(client.resty.Header.Get("User-Agent"), expected))
+	}
+}
+
 func TestClient_UseURL(t *testing.T) {
 	client := NewClient(nil)
 
@@ -616,3 +635,279 @@ func TestClient_CustomRootCAWithoutCustomRoundTripper(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_CacheConcurrentAccess(t *testing.T) {
+	client := NewClient(nil)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 100; i++ {
+			client.UseCache(i%2 == 0)
+			client.SetGlobalCacheExpiration(time.Minute)
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		client.addCachedResponse(context.Background(), "regions", []Region{{ID: "us-east"}}, nil)
+		client.getCachedResponse(context.Background(), "regions")
+	}
+
+	<-done
+}
+
+func TestClient_SetCompressionEnabled(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != acceptEncodingHeaderValue {
+			t.Errorf("unexpected Accept-Encoding header: %q", r.Header.Get("Accept-Encoding"))
+		}
+
+		status := http.StatusOK
+		body := `{"id": "us-east"}`
+
+		if r.URL.Path == "/v4/regions/missing" {
+			status = http.StatusNotFound
+			body = `{"errors": [{"reason": "Not found"}]}`
+		}
+
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		_, _ = gz.Write([]byte(body))
+		_ = gz.Close()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+		w.WriteHeader(status)
+		_, _ = w.Write(buf.Bytes())
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(server.URL).SetCompressionEnabled(true)
+
+	region, err := doGETRequest[Region](context.Background(), &client, "regions/us-east")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if region.ID != "us-east" {
+		t.Fatalf("unexpected region ID: %q", region.ID)
+	}
+
+	body, err := doGETRawRequest(context.Background(), &client, "regions/us-east")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := io.ReadAll(body)
+	body.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(raw) != `{"id": "us-east"}` {
+		t.Fatalf("unexpected raw body: %s", raw)
+	}
+
+	_, err = doGETRequest[Region](context.Background(), &client, "regions/missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	client.SetCompressionEnabled(false)
+
+	if _, ok := client.resty.GetClient().Transport.(*decompressingTransport); ok {
+		t.Fatal("expected decompressing transport to be removed")
+	}
+
+	if client.resty.Header.Get("Accept-Encoding") != "" {
+		t.Fatal("expected Accept-Encoding header to be removed")
+	}
+}
+
+type testTracerProvider struct {
+	spans []*testSpan
+}
+
+func (tp *testTracerProvider) Tracer(_ string) Tracer {
+	return tp
+}
+
+func (tp *testTracerProvider) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	span := &testSpan{name: spanName, attributes: make(map[string]any)}
+	tp.spans = append(tp.spans, span)
+
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+type testSpanKey struct{}
+
+type testSpan struct {
+	name       string
+	attributes map[string]any
+	errors     []error
+	ended      bool
+}
+
+func (s *testSpan) SetAttribute(key string, value any) {
+	s.attributes[key] = value
+}
+
+func (s *testSpan) RecordError(err error) {
+	s.errors = append(s.errors, err)
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+func TestClient_SetTracerProvider(t *testing.T) {
+	tp := &testTracerProvider{}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(requestIDHeaderName, "abc123")
+
+		if r.URL.Path == "/v4/regions/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": "us-east"}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(server.URL).SetTracerProvider(tp)
+
+	var requestSpan any
+
+	client.OnAfterResponse(func(r *Response) error {
+		requestSpan = r.RawResponse.Request.Context().Value(testSpanKey{})
+		return nil
+	})
+
+	if _, err := doGETRequest[Region](context.Background(), &client, "regions/us-east"); err != nil {
+		t.Fatal(err)
+	}
+
+	if requestSpan == nil {
+		t.Fatal("expected span to be propagated through the request context")
+	}
+
+	if _, err := doGETRequest[Region](context.Background(), &client, "regions/missing"); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	if len(tp.spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(tp.spans))
+	}
+
+	span := tp.spans[0]
+	if span.name != "GET /v4/regions/{id}" || !span.ended || len(span.errors) != 0 {
+		t.Fatalf("unexpected span: %+v", span)
+	}
+
+	if span.attributes["http.response.status_code"] != http.StatusOK ||
+		span.attributes["url.path"] != "/v4/regions/us-east" ||
+		span.attributes["linode.request_id"] != "abc123" {
+		t.Fatalf("unexpected span attributes: %v", span.attributes)
+	}
+
+	if span := tp.spans[1]; len(span.errors) != 1 || !span.ended {
+		t.Fatalf("expected error to be recorded on span: %+v", span)
+	}
+
+	client.SetTracerProvider(nil)
+
+	if _, ok := client.resty.GetClient().Transport.(*tracingTransport); ok {
+		t.Fatal("expected tracing transport to be removed")
+	}
+}
+
+func TestRouteTemplate(t *testing.T) {
+	for path, want := range map[string]string{
+		"/v4/linode/instances/123/disks/456":                 "/v4/linode/instances/{id}/disks/{id}",
+		"/v4/regions/us-east/availability":                   "/v4/regions/{id}/availability",
+		"/v4/regions/availability":                           "/v4/regions/availability",
+		"/v4/linode/types/g6-standard-2":                     "/v4/linode/types/{id}",
+		"/v4/images/private%2F123":                           "/v4/images/{id}",
+		"/v4/object-storage/buckets/us-east-1/my-bucket/ssl": "/v4/object-storage/buckets/{id}/{id}/ssl",
+		"/v4/networking/ips/192.0.2.1":                       "/v4/networking/ips/{id}",
+		"/v4beta/networking/ipv4/assign":                     "/v4beta/networking/ipv4/assign",
+	} {
+		if got := routeTemplate(path); got != want {
+			t.Errorf("routeTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestClient_TransportWrapperOrdering(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "us-east"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(server.URL)
+
+	base := client.resty.GetClient().Transport
+
+	countWrappers := func() (tracing, decompressing int) {
+		for rt := client.resty.GetClient().Transport; rt != nil; {
+			switch rt.(type) {
+			case *tracingTransport:
+				tracing++
+			case *decompressingTransport:
+				decompressing++
+			}
+
+			w, ok := rt.(transportWrapper)
+			if !ok {
+				break
+			}
+
+			rt = w.baseTransport()
+		}
+
+		return tracing, decompressing
+	}
+
+	first, second := &testTracerProvider{}, &testTracerProvider{}
+
+	client.SetTracerProvider(first).SetCompressionEnabled(true).SetTracerProvider(second)
+
+	if tracing, decompressing := countWrappers(); tracing != 1 || decompressing != 1 {
+		t.Fatalf("expected one tracing and one decompressing transport, got %d and %d", tracing, decompressing)
+	}
+
+	if _, err := doGETRequest[Region](context.Background(), &client, "regions/us-east"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(first.spans) != 0 || len(second.spans) != 1 {
+		t.Fatalf("expected only the current tracer to record spans, got %d and %d", len(first.spans), len(second.spans))
+	}
+
+	client.SetCompressionEnabled(false)
+
+	if tracing, decompressing := countWrappers(); tracing != 1 || decompressing != 0 {
+		t.Fatalf("expected only the tracing transport to remain, got %d and %d", tracing, decompressing)
+	}
+
+	client.SetTracerProvider(nil)
+
+	if client.resty.GetClient().Transport != base {
+		t.Fatal("expected the original transport to be restored")
+	}
+}
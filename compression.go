@@ -0,0 +1,77 @@
+package linodego
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const acceptEncodingHeaderValue = "gzip, deflate"
+
+// decompressingTransport wraps an http.RoundTripper and transparently decodes
+// gzip and deflate encoded response bodies. The Content-Encoding and
+// Content-Length headers are removed from decoded responses, as they describe
+// the encoded body rather than the body returned to the caller.
+type decompressingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *decompressingTransport) baseTransport() http.RoundTripper {
+	return t.base
+}
+
+func (t *decompressingTransport) withBaseTransport(base http.RoundTripper) http.RoundTripper {
+	return &decompressingTransport{base: base}
+}
+
+func (t *decompressingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return resp, nil
+	}
+
+	var body io.ReadCloser
+
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "gzip":
+		body, err = gzip.NewReader(resp.Body)
+	case "deflate":
+		body, err = zlib.NewReader(resp.Body)
+	default:
+		return resp, nil
+	}
+
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	resp.Body = &decompressedBody{ReadCloser: body, raw: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+
+	return resp, nil
+}
+
+// decompressedBody closes both the decoder and the underlying response body.
+type decompressedBody struct {
+	io.ReadCloser
+	raw io.ReadCloser
+}
+
+func (b *decompressedBody) Close() error {
+	err := b.ReadCloser.Close()
+	if rawErr := b.raw.Close(); err == nil {
+		err = rawErr
+	}
+
+	return err
+}
@@ -3,11 +3,20 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// ErrUnknownDatabaseEngine is returned by ValidateDatabaseEngine when an engine is not offered
+// by Linode Managed Databases.
+var ErrUnknownDatabaseEngine = errors.New("unknown database engine")
+
 type (
 	DatabaseEngineType           string
 	DatabaseDayOfWeek            int
@@ -123,6 +132,52 @@ type DatabaseMaintenanceWindow struct {
 	WeekOfMonth *int `json:"week_of_month,omitempty"`
 }
 
+// Validate checks that DayOfWeek and HourOfDay are within the ranges accepted by the API.
+func (w DatabaseMaintenanceWindow) Validate() error {
+	if w.DayOfWeek < DatabaseMaintenanceDayMonday || w.DayOfWeek > DatabaseMaintenanceDaySunday {
+		return fmt.Errorf("invalid maintenance window day_of_week %d: must be between 1 (Monday) and 7 (Sunday)", w.DayOfWeek)
+	}
+
+	if w.HourOfDay < 0 || w.HourOfDay > 23 {
+		return fmt.Errorf("invalid maintenance window hour_of_day %d: must be between 0 and 23", w.HourOfDay)
+	}
+
+	return nil
+}
+
+// validateDatabaseAllowList checks that each allow list entry is an IP address or CIDR range.
+func validateDatabaseAllowList(allowList []string) error {
+	for _, entry := range allowList {
+		if net.ParseIP(entry) != nil {
+			continue
+		}
+
+		if _, _, err := net.ParseCIDR(entry); err != nil {
+			return fmt.Errorf("invalid allow list entry %q: must be an IP address or CIDR range", entry)
+		}
+	}
+
+	return nil
+}
+
+// addToDatabaseAllowList returns allowList with entry appended if it is not already present.
+func addToDatabaseAllowList(allowList []string, entry string) ([]string, bool) {
+	if slices.Contains(allowList, entry) {
+		return allowList, false
+	}
+
+	return append(allowList, entry), true
+}
+
+// removeFromDatabaseAllowList returns allowList without any occurrences of entry.
+func removeFromDatabaseAllowList(allowList []string, entry string) ([]string, bool) {
+	result := slices.DeleteFunc(slices.Clone(allowList), func(e string) bool {
+		return e == entry
+	})
+
+	return result, len(result) != len(allowList)
+}
+
 type DatabaseMaintenanceWindowPending struct {
 	Deadline    *time.Time `json:"-"`
 	Description string     `json:"description"`
@@ -149,8 +204,28 @@ type DatabaseTypeEngineMap struct {
 
 // DatabaseTypeEngine Sizes and Prices
 type DatabaseTypeEngine struct {
-	Quantity int          `json:"quantity"`
-	Price    ClusterPrice `json:"price"`
+	Quantity     int                       `json:"quantity"`
+	Price        ClusterPrice              `json:"price"`
+	RegionPrices []DatabaseTypeRegionPrice `json:"region_prices"`
+}
+
+// DatabaseTypeRegionPrice is the price of a Database Type engine size in a specific region
+type DatabaseTypeRegionPrice struct {
+	ID      string  `json:"id"`
+	Hourly  float32 `json:"hourly"`
+	Monthly float32 `json:"monthly"`
+}
+
+// PriceForRegion returns the price of this engine size in the given region. The region's
+// price override is returned if one exists, otherwise the base price is returned.
+func (e DatabaseTypeEngine) PriceForRegion(region string) ClusterPrice {
+	for _, p := range e.RegionPrices {
+		if p.ID == region {
+			return ClusterPrice{Hourly: p.Hourly, Monthly: p.Monthly}
+		}
+	}
+
+	return e.Price
 }
 
 // ClusterPrice for Hourly and Monthly price models
@@ -162,7 +237,7 @@ type ClusterPrice struct {
 // DatabaseFork describes the source and restore time for the fork for forked DBs
 type DatabaseFork struct {
 	Source      int        `json:"source"`
-	RestoreTime *time.Time `json:"-,omitempty"`
+	RestoreTime *time.Time `json:"-"`
 }
 
 func (d *Database) UnmarshalJSON(b []byte) error {
@@ -205,6 +280,24 @@ func (d *DatabaseFork) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaler interface, formatting
+// RestoreTime in the ISO 8601 form expected by the API.
+func (d DatabaseFork) MarshalJSON() ([]byte, error) {
+	p := struct {
+		Source      int     `json:"source"`
+		RestoreTime *string `json:"restore_time,omitempty"`
+	}{
+		Source: d.Source,
+	}
+
+	if d.RestoreTime != nil {
+		restoreTime := d.RestoreTime.UTC().Format("2006-01-02T15:04:05")
+		p.RestoreTime = &restoreTime
+	}
+
+	return json.Marshal(p)
+}
+
 func (d *DatabaseMaintenanceWindowPending) UnmarshalJSON(b []byte) error {
 	type Mask DatabaseMaintenanceWindowPending
 
@@ -225,7 +318,14 @@ func (d *DatabaseMaintenanceWindowPending) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ListDatabases lists all Database instances in Linode Managed Databases for the account
+// EngineType returns the engine of the Database, which determines whether it can be
+// managed with the MySQL or the PostgreSQL Database methods.
+func (d Database) EngineType() DatabaseEngineType {
+	return DatabaseEngineType(d.Engine)
+}
+
+// ListDatabases lists all Database instances in Linode Managed Databases for the account,
+// regardless of engine. Use Database.EngineType to tell engines apart.
 func (c *Client) ListDatabases(ctx context.Context, opts *ListOptions) ([]Database, error) {
 	return getPaginatedResults[Database](ctx, c, "databases/instances", opts)
 }
@@ -241,6 +341,28 @@ func (c *Client) GetDatabaseEngine(ctx context.Context, _ *ListOptions, engineID
 	return doGETRequest[DatabaseEngine](ctx, c, e)
 }
 
+// ValidateDatabaseEngine checks that engine, in the "engine/version" form accepted by
+// CreateMySQLDatabase and CreatePostgresDatabase (e.g. "mysql/8"), is offered by
+// Linode Managed Databases. Calling it before creating a Database catches typos in
+// engine versions without waiting for the create request to fail.
+func (c *Client) ValidateDatabaseEngine(ctx context.Context, engine string) error {
+	engines, err := c.ListDatabaseEngines(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	available := make([]string, len(engines))
+
+	for i, e := range engines {
+		available[i] = e.Engine + "/" + e.Version
+		if engine == e.ID || engine == available[i] {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w %q, available engines are %s", ErrUnknownDatabaseEngine, engine, strings.Join(available, ", "))
+}
+
 // ListDatabaseTypes lists all Types of Database provided in Linode Managed Databases. This endpoint is cached by default.
 func (c *Client) ListDatabaseTypes(ctx context.Context, opts *ListOptions) ([]DatabaseType, error) {
 	return getPaginatedResults[DatabaseType](ctx, c, "databases/types", opts)
@@ -3,6 +3,9 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -42,7 +45,7 @@ type DomainRecordCreateOptions struct {
 // DomainRecordUpdateOptions fields are those accepted by UpdateDomainRecord
 type DomainRecordUpdateOptions struct {
 	Type     DomainRecordType `json:"type,omitempty"`
-	Name     string           `json:"name,omitempty"`
+	Name     *Null[string]    `json:"name,omitempty"` // NullOf("") moves the record to the zone apex
 	Target   string           `json:"target,omitempty"`
 	Priority *int             `json:"priority,omitempty"` // 0 is valid, so omit only nil values
 	Weight   *int             `json:"weight,omitempty"`   // 0 is valid, so omit only nil values
@@ -53,6 +56,33 @@ type DomainRecordUpdateOptions struct {
 	Tag      *string          `json:"tag,omitempty"`
 }
 
+// DomainRecordsCreateResult is the summary returned by CreateDomainRecords
+type DomainRecordsCreateResult struct {
+	// Created contains the records that were created, in the order they were requested.
+	Created []DomainRecord
+
+	// Failed contains the records that could not be created, in the order they were requested.
+	Failed []DomainRecordCreateFailure
+}
+
+// DomainRecordCreateFailure describes a record that CreateDomainRecords failed to create
+type DomainRecordCreateFailure struct {
+	// Index is the position of the record in the slice passed to CreateDomainRecords.
+	Index   int
+	Options DomainRecordCreateOptions
+	Err     error
+}
+
+// Err returns an error joining all failures, or nil if every record was created.
+func (r DomainRecordsCreateResult) Err() error {
+	errs := make([]error, len(r.Failed))
+	for i, failure := range r.Failed {
+		errs[i] = fmt.Errorf("record %d (%s %s): %w", failure.Index, failure.Options.Type, failure.Options.Name, failure.Err)
+	}
+
+	return errors.Join(errs...)
+}
+
 // DomainRecordType constants start with RecordType and include Linode API Domain Record Types
 type DomainRecordType string
 
@@ -93,7 +123,7 @@ func (d *DomainRecord) UnmarshalJSON(b []byte) error {
 // GetUpdateOptions converts a DomainRecord to DomainRecordUpdateOptions for use in UpdateDomainRecord
 func (d DomainRecord) GetUpdateOptions() (du DomainRecordUpdateOptions) {
 	du.Type = d.Type
-	du.Name = d.Name
+	du.Name = NullOf(d.Name)
 	du.Target = d.Target
 	du.Priority = copyInt(&d.Priority)
 	du.Weight = copyInt(&d.Weight)
@@ -123,6 +153,67 @@ func (c *Client) CreateDomainRecord(ctx context.Context, domainID int, opts Doma
 	return doPOSTRequest[DomainRecord](ctx, c, e, opts)
 }
 
+// CreateDomainRecords creates each of the given DomainRecords, continuing past records
+// that fail, and returns a summary of the records that were and were not created.
+// At most concurrency records are created at a time; values less than 1 create records
+// one at a time. If ctx is done, no further records are requested and the remaining records
+// are reported as failed with ctx.Err().
+func (c *Client) CreateDomainRecords(
+	ctx context.Context, domainID int, records []DomainRecordCreateOptions, concurrency int,
+) DomainRecordsCreateResult {
+	concurrency = max(concurrency, 1)
+
+	created := make([]*DomainRecord, len(records))
+	errs := make([]error, len(records))
+
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, concurrency)
+
+	for i, opts := range records {
+		if ctx.Err() == nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(records); j++ {
+				errs[j] = err
+			}
+
+			break
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			created[i], errs[i] = c.CreateDomainRecord(ctx, domainID, opts)
+		}()
+	}
+
+	wg.Wait()
+
+	var result DomainRecordsCreateResult
+
+	for i, opts := range records {
+		if errs[i] != nil {
+			result.Failed = append(result.Failed, DomainRecordCreateFailure{Index: i, Options: opts, Err: errs[i]})
+			continue
+		}
+
+		result.Created = append(result.Created, *created[i])
+	}
+
+	return result
+}
+
 // UpdateDomainRecord updates the DomainRecord with the specified id
 func (c *Client) UpdateDomainRecord(ctx context.Context, domainID int, recordID int, opts DomainRecordUpdateOptions) (*DomainRecord, error) {
 	e := formatAPIPath("domains/%d/records/%d", domainID, recordID)
@@ -0,0 +1,335 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// DomainZoneFileImportOptions fields are those accepted by ImportDomainFromZoneFile
+type DomainZoneFileImportOptions struct {
+	// The domain the zone file describes. Defaults to the owner of the zone file's
+	// SOA record, and is required if the zone file has no SOA record or uses
+	// relative names without an $ORIGIN directive.
+	Domain string
+
+	// Start of Authority email address. Defaults to the responsible mailbox
+	// in the zone file's SOA record.
+	SOAEmail string
+
+	// A description for the new Domain.
+	Description string
+
+	// An array of tags applied to the new Domain.
+	Tags []string
+
+	// The maximum number of records to create at a time, as in CreateDomainRecords.
+	Concurrency int
+}
+
+// DomainZoneFileSkippedRecord describes a zone file record that ImportDomainFromZoneFile
+// could not import into the new Domain.
+type DomainZoneFileSkippedRecord struct {
+	// Text is the record in zone file presentation format, with its name fully qualified.
+	Text string
+
+	// Reason describes why the record was not imported.
+	Reason string
+}
+
+// ImportDomainFromZoneFile creates a master Domain from the text of a BIND-style zone file.
+// The SOA record is used to configure the Domain, and A, AAAA, CNAME, MX, NS, TXT, SRV and
+// CAA records are created as DomainRecords. NS records for the zone apex are skipped, as
+// the Domain is served by Linode's nameservers. Records whose TTL matches the SOA record's
+// TTL inherit the Domain's TTL.
+//
+// The zone file is parsed with github.com/miekg/dns, so every directive it supports other
+// than $INCLUDE, which is rejected, may be used.
+//
+// Records that could not be translated or created are returned alongside the Domain.
+// An error is returned only if the zone file cannot be parsed or the Domain cannot be created.
+func (c *Client) ImportDomainFromZoneFile(
+	ctx context.Context, zoneText string, opts DomainZoneFileImportOptions,
+) (*Domain, []DomainZoneFileSkippedRecord, error) {
+	zone, err := parseZoneFile(zoneText, opts.Domain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	soaEmail := opts.SOAEmail
+	if soaEmail == "" {
+		soaEmail = zone.soaEmail
+	}
+
+	domain, err := c.CreateDomain(ctx, DomainCreateOptions{
+		Domain:      zone.domain,
+		Type:        DomainTypeMaster,
+		Description: opts.Description,
+		SOAEmail:    soaEmail,
+		RefreshSec:  zone.refreshSec,
+		RetrySec:    zone.retrySec,
+		ExpireSec:   zone.expireSec,
+		TTLSec:      zone.ttlSec,
+		Tags:        opts.Tags,
+	})
+	if err != nil {
+		return nil, zone.skipped, err
+	}
+
+	options := make([]DomainRecordCreateOptions, len(zone.records))
+	for i, record := range zone.records {
+		options[i] = record.opts
+	}
+
+	skipped := zone.skipped
+
+	for _, failure := range c.CreateDomainRecords(ctx, domain.ID, options, opts.Concurrency).Failed {
+		skipped = append(skipped, DomainZoneFileSkippedRecord{
+			Text:   zone.records[failure.Index].text,
+			Reason: failure.Err.Error(),
+		})
+	}
+
+	return domain, skipped, nil
+}
+
+// parsedZoneFile is the result of parsing a zone file
+type parsedZoneFile struct {
+	// domain is the zone apex, without a trailing dot
+	domain   string
+	soaEmail string
+
+	refreshSec int
+	retrySec   int
+	expireSec  int
+	ttlSec     int
+
+	records []parsedZoneRecord
+	skipped []DomainZoneFileSkippedRecord
+}
+
+// parsedZoneRecord is a zone file record translated to a DomainRecord
+type parsedZoneRecord struct {
+	text string
+	opts DomainRecordCreateOptions
+}
+
+// parseZoneFile parses the text of a BIND-style zone file whose apex is domain,
+// or the owner of its SOA record if domain is empty.
+func parseZoneFile(zoneText string, domain string) (*parsedZoneFile, error) {
+	origin := ""
+	if domain != "" {
+		origin = dns.Fqdn(domain)
+	}
+
+	parser := dns.NewZoneParser(strings.NewReader(zoneText), origin, "")
+
+	var (
+		rrs []dns.RR
+		soa *dns.SOA
+	)
+
+	for rr, ok := parser.Next(); ok; rr, ok = parser.Next() {
+		if record, isSOA := rr.(*dns.SOA); isSOA && soa == nil {
+			soa = record
+			continue
+		}
+
+		rrs = append(rrs, rr)
+	}
+
+	if err := parser.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse zone file: %w", err)
+	}
+
+	if origin == "" {
+		if soa == nil {
+			return nil, errors.New("the zone file has no SOA record and no domain was provided")
+		}
+
+		origin = soa.Hdr.Name
+	}
+
+	zone := &parsedZoneFile{domain: strings.ToLower(strings.TrimSuffix(origin, "."))}
+
+	if soa != nil {
+		if err := zone.applySOA(soa); err != nil {
+			zone.skipped = append(zone.skipped, DomainZoneFileSkippedRecord{Text: soa.String(), Reason: err.Error()})
+		}
+	}
+
+	for _, rr := range rrs {
+		opts, reason := zone.translateRecord(rr)
+		if reason != "" {
+			zone.skipped = append(zone.skipped, DomainZoneFileSkippedRecord{Text: rr.String(), Reason: reason})
+			continue
+		}
+
+		zone.records = append(zone.records, parsedZoneRecord{text: rr.String(), opts: opts})
+	}
+
+	return zone, nil
+}
+
+// applySOA configures the zone from its SOA record
+func (z *parsedZoneFile) applySOA(soa *dns.SOA) error {
+	if _, ok := z.relativeName(soa.Hdr.Name); !ok {
+		return fmt.Errorf("SOA record for %s is outside of zone %s", normalizeZoneName(soa.Hdr.Name), z.domain)
+	}
+
+	email, err := soaMailboxToEmail(soa.Mbox)
+	if err != nil {
+		return err
+	}
+
+	z.soaEmail = email
+	z.refreshSec = int(soa.Refresh)
+	z.retrySec = int(soa.Retry)
+	z.expireSec = int(soa.Expire)
+	z.ttlSec = int(soa.Hdr.Ttl)
+
+	return nil
+}
+
+// translateRecord returns the DomainRecordCreateOptions for rr,
+// or a reason if the record cannot be translated.
+func (z *parsedZoneFile) translateRecord(rr dns.RR) (DomainRecordCreateOptions, string) {
+	header := rr.Header()
+
+	name, ok := z.relativeName(header.Name)
+	if !ok {
+		return DomainRecordCreateOptions{}, fmt.Sprintf(
+			"name %s is outside of zone %s", normalizeZoneName(header.Name), z.domain,
+		)
+	}
+
+	opts := DomainRecordCreateOptions{Name: name}
+
+	// Records with the zone's TTL inherit the Domain's TTL
+	if int(header.Ttl) != z.ttlSec {
+		opts.TTLSec = int(header.Ttl)
+	}
+
+	switch record := rr.(type) {
+	case *dns.A:
+		opts.Type = RecordTypeA
+		opts.Target = record.A.String()
+	case *dns.AAAA:
+		opts.Type = RecordTypeAAAA
+		opts.Target = record.AAAA.String()
+	case *dns.CNAME:
+		opts.Type = RecordTypeCNAME
+		opts.Target = normalizeZoneName(record.Target)
+	case *dns.NS:
+		if name == "" {
+			return opts, "NS records for the zone apex are managed by Linode"
+		}
+
+		opts.Type = RecordTypeNS
+		opts.Target = normalizeZoneName(record.Ns)
+	case *dns.MX:
+		opts.Type = RecordTypeMX
+		opts.Priority = Pointer(int(record.Preference))
+		opts.Target = normalizeZoneName(record.Mx)
+	case *dns.TXT:
+		rdata, err := packedRdata(record)
+		if err != nil {
+			return opts, err.Error()
+		}
+
+		// The character strings of a TXT record are concatenated into a single target
+		var target strings.Builder
+
+		for len(rdata) > 0 {
+			length := int(rdata[0])
+			target.Write(rdata[1 : 1+length])
+			rdata = rdata[1+length:]
+		}
+
+		opts.Type = RecordTypeTXT
+		opts.Target = target.String()
+	case *dns.SRV:
+		labels := strings.SplitN(name, ".", 3)
+		if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return opts, fmt.Sprintf("SRV record name %q is not of the form _service._protocol", name)
+		}
+
+		opts.Type = RecordTypeSRV
+		opts.Service = Pointer(strings.TrimPrefix(labels[0], "_"))
+		opts.Protocol = Pointer(strings.TrimPrefix(labels[1], "_"))
+		opts.Priority = Pointer(int(record.Priority))
+		opts.Weight = Pointer(int(record.Weight))
+		opts.Port = Pointer(int(record.Port))
+		opts.Target = normalizeZoneName(record.Target)
+		opts.Name = ""
+
+		if len(labels) == 3 {
+			opts.Name = labels[2]
+		}
+	case *dns.CAA:
+		rdata, err := packedRdata(record)
+		if err != nil {
+			return opts, err.Error()
+		}
+
+		// The value follows the flags and the length-prefixed tag
+		opts.Type = RecordTypeCAA
+		opts.Tag = Pointer(record.Tag)
+		opts.Target = string(rdata[2+len(record.Tag):])
+	default:
+		return opts, fmt.Sprintf("unsupported record type %s", dns.TypeToString[header.Rrtype])
+	}
+
+	return opts, ""
+}
+
+// relativeName returns the fully-qualified name relative to the zone apex,
+// or false if it is outside the zone.
+func (z *parsedZoneFile) relativeName(fqdn string) (string, bool) {
+	name := normalizeZoneName(fqdn)
+	if name == z.domain {
+		return "", true
+	}
+
+	return strings.CutSuffix(name, "."+z.domain)
+}
+
+// normalizeZoneName returns a fully-qualified zone file name in lower case without its trailing dot
+func normalizeZoneName(fqdn string) string {
+	return strings.ToLower(strings.TrimSuffix(fqdn, "."))
+}
+
+// packedRdata returns the wire format RDATA of rr, in which the
+// presentation format escapes of its strings have been decoded.
+func packedRdata(rr dns.RR) ([]byte, error) {
+	msg := make([]byte, dns.Len(rr))
+
+	off, err := dns.PackRR(rr, msg, 0, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s record: %w", dns.TypeToString[rr.Header().Rrtype], err)
+	}
+
+	return msg[off-int(rr.Header().Rdlength) : off], nil
+}
+
+// soaMailboxToEmail converts an SOA responsible mailbox such as
+// hostmaster.example.com. to an email address such as hostmaster@example.com
+func soaMailboxToEmail(mailbox string) (string, error) {
+	// The first label of the packed name is the local part with its escapes decoded
+	packed := make([]byte, 256)
+	if _, err := dns.PackDomainName(dns.Fqdn(mailbox), packed, 0, nil, false); err != nil {
+		return "", fmt.Errorf("invalid SOA mailbox %q: %w", mailbox, err)
+	}
+
+	local := string(packed[1 : 1+int(packed[0])])
+
+	labels := dns.SplitDomainName(mailbox)
+	if len(labels) < 2 {
+		return local, nil
+	}
+
+	return local + "@" + normalizeZoneName(strings.Join(labels[1:], ".")), nil
+}
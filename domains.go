@@ -2,6 +2,8 @@ package linodego
 
 import (
 	"context"
+	"fmt"
+	"io"
 )
 
 // Domain represents a Domain object
@@ -222,11 +224,46 @@ func (c *Client) DeleteDomain(ctx context.Context, domainID int) error {
 }
 
 // GetDomainZoneFile gets the zone file for the last rendered zone for the specified domain.
+// Slave zones have no rendered zone file, so an empty zone file is returned for them;
+// use GetMasterDomainZoneFile to get an error instead.
 func (c *Client) GetDomainZoneFile(ctx context.Context, domainID int) (*DomainZoneFile, error) {
 	e := formatAPIPath("domains/%d/zone-file", domainID)
 	return doGETRequest[DomainZoneFile](ctx, c, e)
 }
 
+// GetDomainZoneFileReader streams the JSON zone file response of GetDomainZoneFile without
+// buffering it in memory, e.g. to archive large zone files to disk.
+// The caller owns the returned reader and must close it.
+func (c *Client) GetDomainZoneFileReader(ctx context.Context, domainID int) (io.ReadCloser, error) {
+	e := formatAPIPath("domains/%d/zone-file", domainID)
+	return doGETRawRequest(ctx, c, e)
+}
+
+// GetMasterDomainZoneFile gets the zone file as in GetDomainZoneFile, returning an error
+// if the zone file is empty because the domain is a slave zone. Checking the domain type
+// requires an additional request when the zone file is empty.
+func (c *Client) GetMasterDomainZoneFile(ctx context.Context, domainID int) (*DomainZoneFile, error) {
+	zoneFile, err := c.GetDomainZoneFile(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Slave zones are rendered as an empty zone file, check the domain type
+	// to distinguish them from master zones which have not yet been rendered.
+	if len(zoneFile.ZoneFile) == 0 {
+		domain, err := c.GetDomain(ctx, domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		if domain.Type == DomainTypeSlave {
+			return nil, fmt.Errorf("domain %d is a slave zone and has no zone file", domainID)
+		}
+	}
+
+	return zoneFile, nil
+}
+
 // CloneDomain clones a Domain and all associated DNS records from a Domain that is registered in Linode's DNS manager.
 func (c *Client) CloneDomain(ctx context.Context, domainID int, opts DomainCloneOptions) (*Domain, error) {
 	e := formatAPIPath("domains/%d/clone", domainID)
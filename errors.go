@@ -1,13 +1,16 @@
 package linodego
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -22,13 +25,44 @@ const (
 	ErrorFromStringer
 )
 
+const requestIDHeaderName = "X-Linode-Request-ID"
+
+// MaxErrorRawBodySize is the maximum number of bytes of a response body
+// attached to Error.RawBody when an error response cannot be decoded.
+const MaxErrorRawBodySize = 4096
+
 // Error wraps the LinodeGo error with the relevant http.Response
 type Error struct {
 	Response *http.Response
 	Code     int
 	Message  string
+
+	// Errors contains the individual reasons returned by the Linode API, if any
+	Errors []APIErrorReason
+
+	// RequestID is the value of the X-Linode-Request-ID response header, if any
+	RequestID string
+
+	// RawBody contains the beginning of the response body, up to MaxErrorRawBodySize
+	// bytes, for error responses that could not be decoded as an API error.
+	// This helps distinguish gateway or proxy error pages from API errors.
+	RawBody []byte
+}
+
+// RateLimitError is returned when the Linode API responds with 429 Too Many Requests.
+// It embeds the underlying *Error, so fields such as Code and Message can be read
+// directly, and Unwrap returns it for errors.As.
+// RetryAfter is populated from the Retry-After response header, and is zero
+// if the header is absent or cannot be parsed.
+type RateLimitError struct {
+	*rateLimitedError
+	RetryAfter time.Duration
 }
 
+// rateLimitedError is embedded in RateLimitError in place of *Error, since a field
+// named Error would hide the Error method and keep RateLimitError from being an error.
+type rateLimitedError = Error
+
 // APIErrorReason is an individual invalid request message returned by the Linode API
 type APIErrorReason struct {
 	Reason string `json:"reason"`
@@ -56,7 +90,14 @@ func (r APIErrorReason) String() string {
 func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 	if err != nil {
 		// an error was raised in go code, no need to check the resty Response
-		return nil, NewError(err)
+		e := NewError(err)
+
+		// the response may have failed to decode, so keep the body for debugging
+		if r != nil && r.RawResponse != nil && r.IsError() {
+			e.RawBody = preserveRawBody(r.RawResponse, r.Body())
+		}
+
+		return nil, e
 	}
 
 	if r.Error() == nil {
@@ -66,6 +107,12 @@ func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 
 	// handle the resty Response errors
 
+	// Rate limit responses may come from the edge rather than the API, so they are
+	// handled before the Content-Type check below.
+	if r.StatusCode() == http.StatusTooManyRequests {
+		return nil, newRateLimitError(r)
+	}
+
 	// Check that response is of the correct content-type before unmarshalling
 	expectedContentType := r.Request.Header.Get("Accept")
 	responseContentType := r.Header().Get("Content-Type")
@@ -74,7 +121,12 @@ func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 	// the http server will respond with a default "Bad Gateway" page with Content-Type
 	// "text/html".
 	if r.StatusCode() == http.StatusBadGateway && responseContentType == "text/html" { //nolint:goconst
-		return nil, Error{Code: http.StatusBadGateway, Message: http.StatusText(http.StatusBadGateway)}
+		return nil, Error{
+			Code:      http.StatusBadGateway,
+			Message:   http.StatusText(http.StatusBadGateway),
+			RequestID: r.Header().Get(requestIDHeaderName),
+			RawBody:   preserveRawBody(r.RawResponse, r.Body()),
+		}
 	}
 
 	if responseContentType != expectedContentType {
@@ -85,7 +137,12 @@ func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 			string(r.Body()),
 		)
 
-		return nil, Error{Code: r.StatusCode(), Message: msg}
+		return nil, Error{
+			Code:      r.StatusCode(),
+			Message:   msg,
+			RequestID: r.Header().Get(requestIDHeaderName),
+			RawBody:   preserveRawBody(r.RawResponse, r.Body()),
+		}
 	}
 
 	apiError, ok := r.Error().(*APIError)
@@ -96,6 +153,42 @@ func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 	return nil, NewError(r)
 }
 
+// preserveRawBody resets the body of resp so it can be re-read by the caller,
+// and returns a copy of body truncated to MaxErrorRawBodySize.
+func preserveRawBody(resp *http.Response, body []byte) []byte {
+	if resp != nil && body != nil {
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	return bytes.Clone(body[:min(len(body), MaxErrorRawBodySize)])
+}
+
+func newRateLimitError(r *resty.Response) *RateLimitError {
+	err := &Error{
+		Code:      http.StatusTooManyRequests,
+		Message:   http.StatusText(http.StatusTooManyRequests),
+		Response:  r.RawResponse,
+		RequestID: r.Header().Get(requestIDHeaderName),
+	}
+
+	if apiError, ok := r.Error().(*APIError); ok && len(apiError.Errors) > 0 {
+		err = NewError(r)
+	} else {
+		err.RawBody = preserveRawBody(r.RawResponse, r.Body())
+	}
+
+	var retryAfter time.Duration
+	if seconds, parseErr := strconv.Atoi(r.Header().Get(retryAfterHeaderName)); parseErr == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+
+	return &RateLimitError{rateLimitedError: err, RetryAfter: retryAfter}
+}
+
 //nolint:unused
 func coupleAPIErrorsHTTP(resp *http.Response, err error) (*http.Response, error) {
 	if err != nil {
@@ -111,7 +204,14 @@ func coupleAPIErrorsHTTP(resp *http.Response, err error) (*http.Response, error)
 		// If the upstream server fails to respond to the request,
 		// the http server will respond with a default error page with Content-Type "text/html".
 		if resp.StatusCode == http.StatusBadGateway && responseContentType == "text/html" { //nolint:goconst
-			return nil, Error{Code: http.StatusBadGateway, Message: http.StatusText(http.StatusBadGateway)}
+			bodyBytes, _ := io.ReadAll(resp.Body)
+
+			return nil, Error{
+				Code:      http.StatusBadGateway,
+				Message:   http.StatusText(http.StatusBadGateway),
+				RequestID: resp.Header.Get(requestIDHeaderName),
+				RawBody:   preserveRawBody(resp, bodyBytes),
+			}
 		}
 
 		if responseContentType != expectedContentType {
@@ -123,19 +223,39 @@ func coupleAPIErrorsHTTP(resp *http.Response, err error) (*http.Response, error)
 				string(bodyBytes),
 			)
 
-			return nil, Error{Code: resp.StatusCode, Message: msg}
+			return nil, Error{
+				Code:      resp.StatusCode,
+				Message:   msg,
+				RequestID: resp.Header.Get(requestIDHeaderName),
+				RawBody:   preserveRawBody(resp, bodyBytes),
+			}
+		}
+
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, NewError(fmt.Errorf("failed to read response body: %w", err))
 		}
 
 		var apiError APIError
-		if err := json.NewDecoder(resp.Body).Decode(&apiError); err != nil {
-			return nil, NewError(fmt.Errorf("failed to decode response body: %w", err))
+		if err := json.Unmarshal(bodyBytes, &apiError); err != nil {
+			e := NewError(fmt.Errorf("failed to decode response body: %w", err))
+			e.RawBody = preserveRawBody(resp, bodyBytes)
+
+			return nil, e
 		}
 
+		preserveRawBody(resp, bodyBytes)
+
 		if len(apiError.Errors) == 0 {
 			return resp, nil
 		}
 
-		return nil, Error{Code: resp.StatusCode, Message: apiError.Errors[0].String()}
+		return nil, Error{
+			Code:      resp.StatusCode,
+			Message:   apiError.Errors[0].String(),
+			Errors:    apiError.Errors,
+			RequestID: resp.Header.Get(requestIDHeaderName),
+		}
 	}
 
 	// no error in the http.Response
@@ -172,9 +292,11 @@ func NewError(err any) *Error {
 		}
 
 		return &Error{
-			Code:     e.RawResponse.StatusCode,
-			Message:  apiError.Error(),
-			Response: e.RawResponse,
+			Code:      e.RawResponse.StatusCode,
+			Message:   apiError.Error(),
+			Response:  e.RawResponse,
+			Errors:    apiError.Errors,
+			RequestID: e.RawResponse.Header.Get(requestIDHeaderName),
 		}
 	case error:
 		return &Error{Code: ErrorFromError, Message: e.Error()}
@@ -188,9 +310,25 @@ func NewError(err any) *Error {
 }
 
 func (err Error) Error() string {
+	if err.RequestID != "" {
+		return fmt.Sprintf("[%03d] %s (request-id: %s)", err.Code, err.Message, err.RequestID)
+	}
+
 	return fmt.Sprintf("[%03d] %s", err.Code, err.Message)
 }
 
+// Reasons returns the individual field-level reasons returned by the Linode API,
+// or nil if the Error did not originate from an API error response.
+// Reasons are decoded once when the Error is created, so repeated calls
+// do not re-read the response body.
+func (err Error) Reasons() []APIErrorReason {
+	if len(err.Errors) == 0 {
+		return nil
+	}
+
+	return err.Errors
+}
+
 func (err Error) StatusCode() int {
 	return err.Code
 }
@@ -203,6 +341,10 @@ func (err Error) Is(target error) bool {
 	return false
 }
 
+func (err *RateLimitError) Unwrap() error {
+	return err.rateLimitedError
+}
+
 // IsNotFound indicates if err indicates a 404 Not Found error from the Linode API.
 func IsNotFound(err error) bool {
 	return ErrHasStatus(err, http.StatusNotFound)
@@ -233,3 +375,33 @@ func ErrHasStatus(err error, code ...int) bool {
 	}
 	return false
 }
+
+// ErrHasReason checks if err is an error from the Linode API, and whether any of its
+// reasons contain one of the given substrings. Matching is case-insensitive.
+// More than one substring may be given.
+// If len(substr) == 0, err is nil or is not a [Error], ErrHasReason will return false.
+func ErrHasReason(err error, substr ...string) bool {
+	if err == nil {
+		return false
+	}
+
+	// Short-circuit if the caller did not provide any substrings.
+	if len(substr) == 0 {
+		return false
+	}
+
+	var e *Error
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	for _, r := range e.Reasons() {
+		reason := strings.ToLower(r.Reason)
+		for _, s := range substr {
+			if strings.Contains(reason, strings.ToLower(s)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -9,7 +9,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-cmp/cmp"
@@ -161,6 +163,7 @@ func TestCoupleAPIErrors(t *testing.T) {
 		expectedError := Error{
 			Code:    http.StatusInternalServerError,
 			Message: "Unexpected Content-Type: Expected: application/json, Received: text/html\nResponse body: " + rawResponse,
+			RawBody: []byte(rawResponse),
 		}
 
 		_, err := coupleAPIErrors(client.R(context.Background()).SetResult(&Instance{}).Get(ts.URL + route))
@@ -295,6 +298,7 @@ func TestCoupleAPIErrorsHTTP(t *testing.T) {
 		expectedError := Error{
 			Code:    http.StatusInternalServerError,
 			Message: "Unexpected Content-Type: Expected: application/json, Received: text/html\nResponse body: " + rawResponse,
+			RawBody: []byte(rawResponse),
 		}
 
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, ts.URL+route, nil)
@@ -340,15 +344,49 @@ func TestCoupleAPIErrorsHTTP(t *testing.T) {
 		expectedError := Error{
 			Code:    http.StatusBadGateway,
 			Message: http.StatusText(http.StatusBadGateway),
+			RawBody: []byte(rawResponse),
 		}
 
 		_, err := coupleAPIErrorsHTTP(resp, nil)
 		if !cmp.Equal(err, expectedError) {
 			t.Errorf("expected error %#v to match error %#v", err, expectedError)
 		}
+
+		// The body should still be readable by the caller
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != rawResponse {
+			t.Errorf("expected response body to be re-readable, got %q", body)
+		}
 	})
 }
 
+func TestCoupleAPIErrorsRawBodyTruncated(t *testing.T) {
+	rawResponse := "<html>" + strings.Repeat("not json", MaxErrorRawBodySize) + "</html>"
+
+	resp := &http.Response{
+		StatusCode: http.StatusInternalServerError,
+		Body:       io.NopCloser(bytes.NewBufferString(rawResponse)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Request:    &http.Request{Header: http.Header{"Accept": []string{"application/json"}}},
+	}
+
+	_, err := coupleAPIErrorsHTTP(resp, nil)
+
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected an Error, got %v", err)
+	}
+
+	if string(e.RawBody) != rawResponse[:MaxErrorRawBodySize] {
+		t.Errorf("expected raw body truncated to %d bytes, got %d", MaxErrorRawBodySize, len(e.RawBody))
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != rawResponse {
+		t.Errorf("expected full response body to be re-readable, got %q", body)
+	}
+}
+
 func TestErrorIs(t *testing.T) {
 	t.Parallel()
 
@@ -516,3 +554,234 @@ func TestErrHasStatusCode(t *testing.T) {
 		})
 	}
 }
+
+func TestErrHasReason(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		substrs []string
+		match   bool
+	}{
+		{
+			name:    "ExactReason",
+			err:     NewError(restyError("Label must be unique", "label")),
+			substrs: []string{"Label must be unique"},
+			match:   true,
+		},
+		{
+			name:    "CaseInsensitive",
+			err:     NewError(restyError("Label must be unique", "label")),
+			substrs: []string{"label MUST be"},
+			match:   true,
+		},
+		{
+			name:    "MultipleSubstrings",
+			err:     NewError(restyError("Linode busy.", "id")),
+			substrs: []string{"provisioning", "busy"},
+			match:   true,
+		},
+		{
+			name:    "Wrapped",
+			err:     fmt.Errorf("wrapped: %w", NewError(restyError("Linode busy.", "id"))),
+			substrs: []string{"busy"},
+			match:   true,
+		},
+		{
+			name:    "FieldIsNotMatched",
+			err:     NewError(restyError("must be unique", "label")),
+			substrs: []string{"label"},
+		},
+		{
+			name: "NoSubstrings",
+			err:  NewError(restyError("Linode busy.", "id")),
+		},
+		{
+			name:    "NoReasons",
+			err:     &Error{Code: http.StatusBadRequest, Message: "Linode busy."},
+			substrs: []string{"busy"},
+		},
+		{
+			name:    "NotALinodeError",
+			err:     io.EOF,
+			substrs: []string{"EOF"},
+		},
+		{
+			name:    "NilError",
+			substrs: []string{"busy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ErrHasReason(tt.err, tt.substrs...)
+			if !got && tt.match {
+				t.Errorf("should have matched")
+			} else if got && !tt.match {
+				t.Errorf("should not have matched")
+			}
+		})
+	}
+}
+
+func TestErrorReasons(t *testing.T) {
+	t.Run("resty response error", func(t *testing.T) {
+		err := NewError(restyError("testreason", "testfield"))
+
+		expected := []APIErrorReason{{Reason: "testreason", Field: "testfield"}}
+		if diff := cmp.Diff(expected, err.Reasons()); diff != "" {
+			t.Errorf("unexpected reasons:\n%s", diff)
+		}
+
+		// Reasons should be stable across repeated calls
+		if diff := cmp.Diff(expected, err.Reasons()); diff != "" {
+			t.Errorf("unexpected reasons on second call:\n%s", diff)
+		}
+	})
+
+	t.Run("http response error", func(t *testing.T) {
+		apiError := APIError{
+			Errors: []APIErrorReason{
+				{Reason: "label must be unique", Field: "label"},
+				{Reason: "region is required", Field: "region"},
+			},
+		}
+		apiErrorBody, _ := json.Marshal(apiError)
+
+		resp := &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(bytes.NewBuffer(apiErrorBody)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Request:    &http.Request{Header: http.Header{"Accept": []string{"application/json"}}},
+		}
+
+		_, err := coupleAPIErrorsHTTP(resp, nil)
+
+		var e Error
+		if !errors.As(err, &e) {
+			t.Fatalf("expected Error, got %T", err)
+		}
+
+		if diff := cmp.Diff(apiError.Errors, e.Reasons()); diff != "" {
+			t.Errorf("unexpected reasons:\n%s", diff)
+		}
+	})
+
+	t.Run("non-api error", func(t *testing.T) {
+		if reasons := NewError("stringerror").Reasons(); reasons != nil {
+			t.Errorf("expected nil reasons, got %v", reasons)
+		}
+	})
+}
+
+func TestCoupleAPIErrorsRateLimit(t *testing.T) {
+	rateLimitResponse := func(retryAfter string) *resty.Response {
+		header := http.Header{}
+		if retryAfter != "" {
+			header.Set(retryAfterHeaderName, retryAfter)
+		}
+
+		return &resty.Response{
+			RawResponse: &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     header,
+			},
+			Request: &resty.Request{
+				Error: &APIError{
+					Errors: []APIErrorReason{{Reason: "Too many requests"}},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name       string
+		retryAfter string
+		expected   time.Duration
+	}{
+		{name: "with retry-after", retryAfter: "13", expected: 13 * time.Second},
+		{name: "missing retry-after", expected: 0},
+		{name: "unparseable retry-after", retryAfter: "soon", expected: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := coupleAPIErrors(rateLimitResponse(tc.retryAfter), nil)
+
+			var rl *RateLimitError
+			if !errors.As(err, &rl) {
+				t.Fatalf("expected RateLimitError, got %T", err)
+			}
+
+			if rl.RetryAfter != tc.expected {
+				t.Errorf("expected RetryAfter %s, got %s", tc.expected, rl.RetryAfter)
+			}
+
+			if err.Error() != "[429] Too many requests" {
+				t.Errorf("unexpected error message: %s", err.Error())
+			}
+
+			if !ErrHasStatus(err, http.StatusTooManyRequests) {
+				t.Errorf("expected rate limit error to match status %d", http.StatusTooManyRequests)
+			}
+
+			if rl.Code != http.StatusTooManyRequests || rl.Message != "Too many requests" {
+				t.Errorf("expected embedded Error fields, got code %d and message %q", rl.Code, rl.Message)
+			}
+		})
+	}
+
+	t.Run("non-json body", func(t *testing.T) {
+		body := []byte("rate limit exceeded")
+		resp := &resty.Response{
+			RawResponse: &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header: http.Header{
+					"Content-Type":       []string{"text/plain"},
+					retryAfterHeaderName: []string{"7"},
+				},
+				Body: io.NopCloser(bytes.NewReader(body)),
+			},
+			Request: &resty.Request{
+				Header: http.Header{"Accept": []string{"application/json"}},
+				Error:  &APIError{},
+			},
+		}
+		resp.SetBody(body)
+
+		_, err := coupleAPIErrors(resp, nil)
+
+		var rl *RateLimitError
+		if !errors.As(err, &rl) {
+			t.Fatalf("expected RateLimitError, got %T: %v", err, err)
+		}
+
+		if rl.RetryAfter != 7*time.Second {
+			t.Errorf("expected RetryAfter 7s, got %s", rl.RetryAfter)
+		}
+
+		if err.Error() != "[429] Too Many Requests" {
+			t.Errorf("unexpected error message: %s", err.Error())
+		}
+
+		if !bytes.Equal(rl.RawBody, body) {
+			t.Errorf("expected raw body %q, got %q", body, rl.RawBody)
+		}
+	})
+}
+
+func TestErrorRequestID(t *testing.T) {
+	resp := restyError("testreason", "testfield")
+	resp.RawResponse.Header = http.Header{}
+	resp.RawResponse.Header.Set(requestIDHeaderName, "abc-123")
+
+	err := NewError(resp)
+	if err.RequestID != "abc-123" {
+		t.Errorf("expected request ID %q, got %q", "abc-123", err.RequestID)
+	}
+
+	if _, err := coupleAPIErrors(resp, nil); err.Error() != "[500] [testfield] testreason (request-id: abc-123)" {
+		t.Errorf("unexpected error message: %s", err.Error())
+	}
+
+	if err := NewError(restyError("testreason", "testfield")); err.Error() != "[500] [testfield] testreason" {
+		t.Errorf("error without request ID should not include it: %s", err.Error())
+	}
+}
@@ -33,10 +33,78 @@ type Filter struct {
 	Order string
 }
 
+// NewFilter returns an empty Filter that can be built up with the chainable
+// comparison methods, e.g. NewFilter().Eq("status", "running").Gte("vcpus", 2).
+// Multiple conditions on the same field are combined with "+and".
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
 func (f *Filter) AddField(op FilterOperator, key string, value any) {
 	f.Children = append(f.Children, &Comp{key, op, value})
 }
 
+// Eq adds a condition that field equals value and returns the Filter for chaining.
+func (f *Filter) Eq(field string, value any) *Filter {
+	f.AddField(Eq, field, value)
+	return f
+}
+
+// Neq adds a condition that field does not equal value and returns the Filter for chaining.
+func (f *Filter) Neq(field string, value any) *Filter {
+	f.AddField(Neq, field, value)
+	return f
+}
+
+// Gt adds a condition that field is greater than value and returns the Filter for chaining.
+func (f *Filter) Gt(field string, value any) *Filter {
+	f.AddField(Gt, field, value)
+	return f
+}
+
+// Gte adds a condition that field is greater than or equal to value and returns the Filter for chaining.
+func (f *Filter) Gte(field string, value any) *Filter {
+	f.AddField(Gte, field, value)
+	return f
+}
+
+// Lt adds a condition that field is less than value and returns the Filter for chaining.
+func (f *Filter) Lt(field string, value any) *Filter {
+	f.AddField(Lt, field, value)
+	return f
+}
+
+// Lte adds a condition that field is less than or equal to value and returns the Filter for chaining.
+func (f *Filter) Lte(field string, value any) *Filter {
+	f.AddField(Lte, field, value)
+	return f
+}
+
+// Contains adds a condition that field contains value and returns the Filter for chaining.
+func (f *Filter) Contains(field string, value any) *Filter {
+	f.AddField(Contains, field, value)
+	return f
+}
+
+// SortBy sets the field and direction (Ascending/Descending) results are ordered by
+// and returns the Filter for chaining.
+func (f *Filter) SortBy(field string, order string) *Filter {
+	f.OrderBy = field
+	f.Order = order
+
+	return f
+}
+
+// Build serializes the Filter into the X-Filter string expected by ListOptions.Filter.
+func (f *Filter) Build() (string, error) {
+	result, err := f.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
 func (f *Filter) MarshalJSON() ([]byte, error) {
 	result := make(map[string]any)
 
@@ -48,12 +116,19 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		result["+order"] = f.Order
 	}
 
-	if f.Operator == "" {
-		for _, c := range f.Children {
-			result[c.Key()] = c.JSONValueSegment()
+	operator := f.Operator
+
+	if operator == "" {
+		if !hasDuplicateKeys(f.Children) {
+			for _, c := range f.Children {
+				result[c.Key()] = c.JSONValueSegment()
+			}
+
+			return json.Marshal(result)
 		}
 
-		return json.Marshal(result)
+		// Conditions on the same field would overwrite each other in a single object
+		operator = "+and"
 	}
 
 	fields := make([]map[string]any, len(f.Children))
@@ -63,11 +138,24 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	result[f.Operator] = fields
+	result[operator] = fields
 
 	return json.Marshal(result)
 }
 
+func hasDuplicateKeys(nodes []FilterNode) bool {
+	seen := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		if seen[n.Key()] {
+			return true
+		}
+
+		seen[n.Key()] = true
+	}
+
+	return false
+}
+
 type Comp struct {
 	Column   string
 	Operator FilterOperator
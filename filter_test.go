@@ -162,3 +162,61 @@ func TestFilterOr(t *testing.T) {
 		t.Fatal(string(result), " doesn't match ", string(expectedStr))
 	}
 }
+
+func TestFilterBuilder(t *testing.T) {
+	expected := map[string]any{
+		"status":    "running",
+		"label":     map[string]any{"+contains": "web"},
+		"vcpus":     map[string]any{"+gt": 2},
+		"+order_by": "created",
+		"+order":    Descending,
+	}
+
+	expectedStr, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatalf("failed to marshal expected json: %v", err)
+	}
+
+	result, err := NewFilter().
+		Eq("status", "running").
+		Contains("label", "web").
+		Gt("vcpus", 2).
+		SortBy("created", Descending).
+		Build()
+	if err != nil {
+		t.Fatalf("failed to build filter: %v", err)
+	}
+
+	if result != string(expectedStr) {
+		t.Fatal(result, " doesn't match ", string(expectedStr))
+	}
+
+	opts := NewListOptions(0, result)
+	if opts.Filter != result {
+		t.Fatalf("unexpected list options filter: %s", opts.Filter)
+	}
+}
+
+func TestFilterBuilderSameField(t *testing.T) {
+	expected := map[string]any{
+		"+and": []map[string]any{
+			{"vcpus": map[string]any{"+gte": 2}},
+			{"vcpus": map[string]any{"+lte": 8}},
+			{"status": "running"},
+		},
+	}
+
+	expectedStr, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatalf("failed to marshal expected json: %v", err)
+	}
+
+	result, err := NewFilter().Gte("vcpus", 2).Lte("vcpus", 8).Eq("status", "running").Build()
+	if err != nil {
+		t.Fatalf("failed to build filter: %v", err)
+	}
+
+	if result != string(expectedStr) {
+		t.Fatal(result, " doesn't match ", string(expectedStr))
+	}
+}
@@ -82,3 +82,79 @@ func (c *Client) DeleteFirewallDevice(ctx context.Context, firewallID, deviceID
 	e := formatAPIPath("networking/firewalls/%d/devices/%d", firewallID, deviceID)
 	return doDELETERequest(ctx, c, e)
 }
+
+// AttachFirewallToInstance associates the given Linode instance with a Firewall.
+// If the instance is already attached, its existing FirewallDevice is returned.
+func (c *Client) AttachFirewallToInstance(ctx context.Context, firewallID, linodeID int) (*FirewallDevice, error) {
+	return c.attachFirewallDevice(ctx, firewallID, FirewallDeviceLinode, linodeID)
+}
+
+// AttachFirewallToNodeBalancer associates the given NodeBalancer with a Firewall.
+// If the NodeBalancer is already attached, its existing FirewallDevice is returned.
+func (c *Client) AttachFirewallToNodeBalancer(ctx context.Context, firewallID, nodebalancerID int) (*FirewallDevice, error) {
+	return c.attachFirewallDevice(ctx, firewallID, FirewallDeviceNodeBalancer, nodebalancerID)
+}
+
+// DetachFirewallFromInstance disassociates the given Linode instance from a Firewall.
+// No error is returned if the instance is not attached.
+func (c *Client) DetachFirewallFromInstance(ctx context.Context, firewallID, linodeID int) error {
+	return c.detachFirewallDevice(ctx, firewallID, FirewallDeviceLinode, linodeID)
+}
+
+// DetachFirewallFromNodeBalancer disassociates the given NodeBalancer from a Firewall.
+// No error is returned if the NodeBalancer is not attached.
+func (c *Client) DetachFirewallFromNodeBalancer(ctx context.Context, firewallID, nodebalancerID int) error {
+	return c.detachFirewallDevice(ctx, firewallID, FirewallDeviceNodeBalancer, nodebalancerID)
+}
+
+// findFirewallDevice returns the FirewallDevice for the given entity, or nil if
+// the entity is not attached to the Firewall.
+func (c *Client) findFirewallDevice(
+	ctx context.Context, firewallID int, deviceType FirewallDeviceType, entityID int,
+) (*FirewallDevice, error) {
+	devices, err := c.ListFirewallDevices(ctx, firewallID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		if device.Entity.Type == deviceType && device.Entity.ID == entityID {
+			return &device, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) attachFirewallDevice(
+	ctx context.Context, firewallID int, deviceType FirewallDeviceType, entityID int,
+) (*FirewallDevice, error) {
+	device, err := c.findFirewallDevice(ctx, firewallID, deviceType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if device != nil {
+		return device, nil
+	}
+
+	return c.CreateFirewallDevice(ctx, firewallID, FirewallDeviceCreateOptions{
+		ID:   entityID,
+		Type: deviceType,
+	})
+}
+
+func (c *Client) detachFirewallDevice(
+	ctx context.Context, firewallID int, deviceType FirewallDeviceType, entityID int,
+) error {
+	device, err := c.findFirewallDevice(ctx, firewallID, deviceType, entityID)
+	if err != nil {
+		return err
+	}
+
+	if device == nil {
+		return nil
+	}
+
+	return c.DeleteFirewallDevice(ctx, firewallID, device.ID)
+}
@@ -2,6 +2,11 @@ package linodego
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
 )
 
 // NetworkProtocol enum type
@@ -39,6 +44,28 @@ type FirewallRuleSet struct {
 	OutboundPolicy string         `json:"outbound_policy"`
 }
 
+// FirewallRuleDirection is the direction of traffic a FirewallRule applies to
+type FirewallRuleDirection string
+
+// FirewallRuleDirection enum values
+const (
+	FirewallRuleInbound  FirewallRuleDirection = "inbound"
+	FirewallRuleOutbound FirewallRuleDirection = "outbound"
+)
+
+// Hash returns the sha256 hash of the FirewallRuleSet.
+// This can be used to detect whether the rules have changed between reads.
+func (r FirewallRuleSet) Hash() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash FirewallRuleSet: %w", err)
+	}
+
+	h := sha256.Sum256(data)
+
+	return hex.EncodeToString(h[:]), nil
+}
+
 // GetFirewallRules gets the FirewallRuleSet for the given Firewall.
 func (c *Client) GetFirewallRules(ctx context.Context, firewallID int) (*FirewallRuleSet, error) {
 	e := formatAPIPath("networking/firewalls/%d/rules", firewallID)
@@ -50,3 +77,58 @@ func (c *Client) UpdateFirewallRules(ctx context.Context, firewallID int, rules
 	e := formatAPIPath("networking/firewalls/%d/rules", firewallID)
 	return doPUTRequest[FirewallRuleSet](ctx, c, e, rules)
 }
+
+// MoveFirewallRule moves the rule at fromIndex to toIndex within the rules of the given
+// direction, preserving all other rules and both policies.
+// If expectedHash is not empty, the rules are only updated if the Hash of the current
+// FirewallRuleSet matches it. Note that this narrows, but does not eliminate, the window
+// in which a concurrent update may be overwritten.
+func (c *Client) MoveFirewallRule(
+	ctx context.Context,
+	firewallID int,
+	direction FirewallRuleDirection,
+	fromIndex, toIndex int,
+	expectedHash string,
+) (*FirewallRuleSet, error) {
+	rules, err := c.GetFirewallRules(ctx, firewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedHash != "" {
+		currentHash, err := rules.Hash()
+		if err != nil {
+			return nil, err
+		}
+
+		if currentHash != expectedHash {
+			return nil, fmt.Errorf("rules for firewall %d have changed: expected hash %s, got %s", firewallID, expectedHash, currentHash)
+		}
+	}
+
+	var target *[]FirewallRule
+
+	switch direction {
+	case FirewallRuleInbound:
+		target = &rules.Inbound
+	case FirewallRuleOutbound:
+		target = &rules.Outbound
+	default:
+		return nil, fmt.Errorf("invalid firewall rule direction: %q", direction)
+	}
+
+	count := len(*target)
+	if fromIndex < 0 || fromIndex >= count {
+		return nil, fmt.Errorf("fromIndex %d is out of range for %d %s rules", fromIndex, count, direction)
+	}
+
+	if toIndex < 0 || toIndex >= count {
+		return nil, fmt.Errorf("toIndex %d is out of range for %d %s rules", toIndex, count, direction)
+	}
+
+	rule := (*target)[fromIndex]
+	moved := slices.Delete(slices.Clone(*target), fromIndex, fromIndex+1)
+	*target = slices.Insert(moved, toIndex, rule)
+
+	return c.UpdateFirewallRules(ctx, firewallID, *rules)
+}
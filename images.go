@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -82,6 +83,9 @@ type ImageUpdateOptions struct {
 // ImageReplicateOptions represents the options accepted by the
 // ReplicateImage(...) function.
 type ImageReplicateOptions struct {
+	// Regions is the complete set of regions the Image should be available in.
+	// Regions the Image already exists in are left as-is, and existing regions
+	// not included are removed.
 	Regions []string `json:"regions"`
 }
 
@@ -108,6 +112,10 @@ type ImageUploadOptions struct {
 	CloudInit   bool      `json:"cloud_init"`
 	Tags        *[]string `json:"tags,omitempty"`
 	Image       io.Reader
+
+	// Progress is an optional callback invoked with the total number of bytes
+	// uploaded so far as the Image is streamed to the upload URL.
+	Progress func(uploaded int64) `json:"-"`
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface
@@ -143,18 +151,58 @@ func (i Image) GetUpdateOptions() (iu ImageUpdateOptions) {
 	return
 }
 
-// ListImages lists Images.
+// ListImages lists Images. This endpoint is cached once enabled with SetEndpointCache.
 func (c *Client) ListImages(ctx context.Context, opts *ListOptions) ([]Image, error) {
-	return getPaginatedResults[Image](
+	endpoint, err := generateListCacheURL("images", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
+		return result.([]Image), nil
+	}
+
+	response, err := getPaginatedResults[Image](
 		ctx,
 		c,
 		"images",
 		opts,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.isEndpointCacheEnabled(endpoint) {
+		c.addCachedResponse(ctx, endpoint, response, nil)
+	}
+
+	return response, nil
 }
 
-// GetImage gets the Image with the provided ID.
+// GetImage gets the Image with the provided ID. This endpoint is cached once enabled with SetEndpointCache.
 func (c *Client) GetImage(ctx context.Context, imageID string) (*Image, error) {
+	e := formatAPIPath("images/%s", imageID)
+
+	if result := c.getCachedResponse(ctx, e); result != nil {
+		result := result.(Image)
+		return &result, nil
+	}
+
+	response, err := c.getImage(ctx, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.isEndpointCacheEnabled(e) {
+		c.addCachedResponse(ctx, e, response, nil)
+	}
+
+	return response, nil
+}
+
+// getImage gets the Image with the provided ID without using the cache,
+// for use when polling for changes to the Image.
+func (c *Client) getImage(ctx context.Context, imageID string) (*Image, error) {
 	return doGETRequest[Image](
 		ctx,
 		c,
@@ -164,6 +212,8 @@ func (c *Client) GetImage(ctx context.Context, imageID string) (*Image, error) {
 
 // CreateImage creates an Image.
 func (c *Client) CreateImage(ctx context.Context, opts ImageCreateOptions) (*Image, error) {
+	defer c.invalidateCachedEndpoints("images")
+
 	return doPOSTRequest[Image](
 		ctx,
 		c,
@@ -174,6 +224,8 @@ func (c *Client) CreateImage(ctx context.Context, opts ImageCreateOptions) (*Ima
 
 // UpdateImage updates the Image with the specified id.
 func (c *Client) UpdateImage(ctx context.Context, imageID string, opts ImageUpdateOptions) (*Image, error) {
+	defer c.invalidateCachedEndpoints("images")
+
 	return doPUTRequest[Image](
 		ctx,
 		c,
@@ -183,7 +235,11 @@ func (c *Client) UpdateImage(ctx context.Context, imageID string, opts ImageUpda
 }
 
 // ReplicateImage replicates an image to a given set of regions.
+// The returned Image's Regions contain the per-region replication status,
+// which can be polled using WaitForImageRegionStatus.
 func (c *Client) ReplicateImage(ctx context.Context, imageID string, opts ImageReplicateOptions) (*Image, error) {
+	defer c.invalidateCachedEndpoints("images")
+
 	return doPOSTRequest[Image](
 		ctx,
 		c,
@@ -194,6 +250,8 @@ func (c *Client) ReplicateImage(ctx context.Context, imageID string, opts ImageR
 
 // DeleteImage deletes the Image with the specified id.
 func (c *Client) DeleteImage(ctx context.Context, imageID string) error {
+	defer c.invalidateCachedEndpoints("images")
+
 	return doDELETERequest(
 		ctx,
 		c,
@@ -203,6 +261,8 @@ func (c *Client) DeleteImage(ctx context.Context, imageID string) error {
 
 // CreateImageUpload creates an Image and an upload URL.
 func (c *Client) CreateImageUpload(ctx context.Context, opts ImageCreateUploadOptions) (*Image, string, error) {
+	defer c.invalidateCachedEndpoints("images")
+
 	result, err := doPOSTRequest[ImageCreateUploadResponse](
 		ctx,
 		c,
@@ -216,22 +276,86 @@ func (c *Client) CreateImageUpload(ctx context.Context, opts ImageCreateUploadOp
 	return result.Image, result.UploadTo, nil
 }
 
-// UploadImageToURL uploads the given image to the given upload URL.
+// UploadImageToURL streams the given image to the given upload URL.
+// If the size of image can be determined, e.g. for an *os.File or *bytes.Reader,
+// it is sent as the Content-Length; otherwise the image is sent with chunked
+// transfer encoding.
 func (c *Client) UploadImageToURL(ctx context.Context, uploadURL string, image io.Reader) error {
+	return c.uploadImageToURL(ctx, uploadURL, image, nil)
+}
+
+func (c *Client) uploadImageToURL(ctx context.Context, uploadURL string, image io.Reader, progress func(int64)) error {
+	size := imageReaderSize(image)
+
+	var body io.Reader = image
+	if progress != nil {
+		body = &imageProgressReader{reader: image, progress: progress}
+	}
+
 	// Linode-specific headers do not need to be sent to this endpoint
-	req := resty.New().SetDebug(c.resty.Debug).R().
+	client := resty.New().SetDebug(c.resty.Debug)
+
+	// A ContentLength of -1 makes net/http send the body chunked
+	client.SetPreRequestHook(func(_ *resty.Client, r *http.Request) error {
+		r.ContentLength = size
+		return nil
+	})
+
+	_, err := coupleAPIErrors(client.R().
 		SetContext(ctx).
-		SetContentLength(true).
 		SetHeader("Content-Type", "application/octet-stream").
-		SetBody(image)
-
-	_, err := coupleAPIErrors(req.
+		SetBody(body).
 		Put(uploadURL))
 
 	return err
 }
 
-// UploadImage creates and uploads an image.
+// imageReaderSize returns the number of bytes remaining in r,
+// or -1 if this cannot be determined without reading it.
+func imageReaderSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case io.Seeker:
+		current, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+
+		end, err := v.Seek(0, io.SeekEnd)
+		if err != nil {
+			return -1
+		}
+
+		if _, err := v.Seek(current, io.SeekStart); err != nil {
+			return -1
+		}
+
+		return end - current
+	}
+
+	return -1
+}
+
+type imageProgressReader struct {
+	reader   io.Reader
+	progress func(int64)
+	total    int64
+}
+
+func (r *imageProgressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.total += int64(n)
+		r.progress(r.total)
+	}
+
+	return n, err
+}
+
+// UploadImage creates and uploads an image, reporting progress to opts.Progress if set.
+// The returned Image is fetched once the upload completes; use WaitForImageStatus
+// with ImageStatusAvailable to wait for the uploaded image to finish processing.
 func (c *Client) UploadImage(ctx context.Context, opts ImageUploadOptions) (*Image, error) {
 	image, uploadURL, err := c.CreateImageUpload(ctx, ImageCreateUploadOptions{
 		Label:       opts.Label,
@@ -244,5 +368,9 @@ func (c *Client) UploadImage(ctx context.Context, opts ImageUploadOptions) (*Ima
 		return nil, err
 	}
 
-	return image, c.UploadImageToURL(ctx, uploadURL, opts.Image)
+	if err := c.uploadImageToURL(ctx, uploadURL, opts.Image, opts.Progress); err != nil {
+		return image, err
+	}
+
+	return c.getImage(ctx, image.ID)
 }
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"slices"
 )
 
 // InstanceConfigInterface contains information about a configuration's network interface
@@ -33,6 +34,20 @@ type InstanceConfigInterfaceCreateOptions struct {
 	IPRanges    []string               `json:"ip_ranges,omitempty"`
 }
 
+// Clone returns a deep copy of the InstanceConfigInterfaceCreateOptions.
+func (o InstanceConfigInterfaceCreateOptions) Clone() InstanceConfigInterfaceCreateOptions {
+	o.SubnetID = copyInt(o.SubnetID)
+	o.IPRanges = slices.Clone(o.IPRanges)
+
+	if o.IPv4 != nil {
+		ipv4 := *o.IPv4
+		ipv4.NAT1To1 = copyString(ipv4.NAT1To1)
+		o.IPv4 = &ipv4
+	}
+
+	return o
+}
+
 type InstanceConfigInterfaceUpdateOptions struct {
 	Primary  bool      `json:"primary,omitempty"`
 	IPv4     *VPCIPv4  `json:"ipv4,omitempty"`
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -154,6 +155,56 @@ func (i InstanceConfig) GetUpdateOptions() InstanceConfigUpdateOptions {
 	}
 }
 
+// SetInterfaces validates the given interfaces and, if valid, assigns them to the options
+// in order (eth0, eth1, ...). At most one interface may be marked Primary and at most one
+// interface may have the public purpose. The options are left unchanged on error.
+func (opts *InstanceConfigCreateOptions) SetInterfaces(ifaces ...InstanceConfigInterfaceCreateOptions) error {
+	if err := validateConfigInterfaces(ifaces); err != nil {
+		return err
+	}
+
+	opts.Interfaces = ifaces
+
+	return nil
+}
+
+// SetInterfaces validates the given interfaces and, if valid, assigns them to the options
+// in order (eth0, eth1, ...). At most one interface may be marked Primary and at most one
+// interface may have the public purpose. The options are left unchanged on error.
+func (opts *InstanceConfigUpdateOptions) SetInterfaces(ifaces ...InstanceConfigInterfaceCreateOptions) error {
+	if err := validateConfigInterfaces(ifaces); err != nil {
+		return err
+	}
+
+	opts.Interfaces = ifaces
+
+	return nil
+}
+
+func validateConfigInterfaces(ifaces []InstanceConfigInterfaceCreateOptions) error {
+	primary, public := -1, -1
+
+	for i, iface := range ifaces {
+		if iface.Primary {
+			if primary >= 0 {
+				return fmt.Errorf("interfaces eth%d and eth%d are both marked primary", primary, i)
+			}
+
+			primary = i
+		}
+
+		if iface.Purpose == InterfacePurposePublic {
+			if public >= 0 {
+				return fmt.Errorf("interfaces eth%d and eth%d both have the public purpose", public, i)
+			}
+
+			public = i
+		}
+	}
+
+	return nil
+}
+
 // ListInstanceConfigs lists InstanceConfigs
 func (c *Client) ListInstanceConfigs(ctx context.Context, linodeID int, opts *ListOptions) ([]InstanceConfig, error) {
 	return getPaginatedResults[InstanceConfig](ctx, c, formatAPIPath("linode/instances/%d/configs", linodeID), opts)
@@ -3,11 +3,17 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// ErrDiskDoesNotFit is returned by ResizeInstanceDiskAndWait when the requested disk size
+// exceeds the disk space available to the Instance's type.
+var ErrDiskDoesNotFit = errors.New("disk does not fit on the Linode")
+
 // InstanceDisk represents an Instance Disk object
 type InstanceDisk struct {
 	ID         int            `json:"id"`
@@ -106,6 +112,32 @@ func (c *Client) CreateInstanceDisk(ctx context.Context, linodeID int, opts Inst
 	return doPOSTRequest[InstanceDisk](ctx, c, e, opts)
 }
 
+// CreateInstanceDiskAndWait creates a new InstanceDisk for the given Instance and waits
+// for the resulting disk_create event to finish before returning the finalized InstanceDisk.
+// It will timeout with an error after timeoutSeconds, and returns an error containing the
+// event's message if the event fails.
+func (c *Client) CreateInstanceDiskAndWait(
+	ctx context.Context, linodeID int, opts InstanceDiskCreateOptions, timeoutSeconds int,
+) (*InstanceDisk, error) {
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionDiskCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	disk, err := c.CreateInstanceDisk(ctx, linodeID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	poller.SecondaryEntityID = disk.ID
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for disk %d to be created: %w", disk.ID, err)
+	}
+
+	return c.GetInstanceDisk(ctx, linodeID, disk.ID)
+}
+
 // UpdateInstanceDisk creates a new InstanceDisk for the given Instance
 func (c *Client) UpdateInstanceDisk(ctx context.Context, linodeID int, diskID int, opts InstanceDiskUpdateOptions) (*InstanceDisk, error) {
 	e := formatAPIPath("linode/instances/%d/disks/%d", linodeID, diskID)
@@ -127,6 +159,69 @@ func (c *Client) ResizeInstanceDisk(ctx context.Context, linodeID int, diskID in
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
+// ResizeInstanceDiskAndWait resizes the Instance disk to the given size in MB and waits for
+// the resulting disk_resize event to finish before returning the finalized InstanceDisk.
+// Before resizing, the new size and the Instance's other disks are checked against the disk
+// space of the Instance's type, and ErrDiskDoesNotFit is returned if they would not fit.
+// It will timeout with an error after timeoutSeconds.
+func (c *Client) ResizeInstanceDiskAndWait(
+	ctx context.Context, linodeID int, diskID int, size int, timeoutSeconds int,
+) (*InstanceDisk, error) {
+	if err := c.checkDiskResizeFits(ctx, linodeID, diskID, size); err != nil {
+		return nil, err
+	}
+
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionDiskResize)
+	if err != nil {
+		return nil, err
+	}
+
+	poller.SecondaryEntityID = diskID
+
+	if err := c.ResizeInstanceDisk(ctx, linodeID, diskID, size); err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for disk %d to be resized: %w", diskID, err)
+	}
+
+	return c.GetInstanceDisk(ctx, linodeID, diskID)
+}
+
+func (c *Client) checkDiskResizeFits(ctx context.Context, linodeID int, diskID int, size int) error {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return err
+	}
+
+	linodeType, err := c.GetType(ctx, instance.Type)
+	if err != nil {
+		return err
+	}
+
+	disks, err := c.ListInstanceDisks(ctx, linodeID, nil)
+	if err != nil {
+		return err
+	}
+
+	available := linodeType.Disk
+	for _, disk := range disks {
+		if disk.ID != diskID {
+			available -= disk.Size
+		}
+	}
+
+	if size > available {
+		return fmt.Errorf(
+			"%w: disk %d cannot be resized to %d MB, Linode %d has %d MB available",
+			ErrDiskDoesNotFit, diskID, size, linodeID, available,
+		)
+	}
+
+	return nil
+}
+
 // PasswordResetInstanceDisk resets the "root" account password on the Instance disk
 func (c *Client) PasswordResetInstanceDisk(ctx context.Context, linodeID int, diskID int, password string) error {
 	opts := map[string]any{
@@ -148,3 +243,41 @@ func (c *Client) CloneInstanceDisk(ctx context.Context, linodeID, diskID int, op
 	e := formatAPIPath("linode/instances/%d/disks/%d/clone", linodeID, diskID)
 	return doPOSTRequest[InstanceDisk](ctx, c, e, opts)
 }
+
+// CloneInstanceDiskToInstance clones the given InstanceDisk from the source Instance to the
+// destination Instance and waits for the clone to finish, returning the new InstanceDisk on
+// the destination. The destination Instance must be powered off and have enough unallocated
+// disk space for the cloned disk. It will timeout with an error after timeoutSeconds.
+func (c *Client) CloneInstanceDiskToInstance(
+	ctx context.Context, srcLinodeID, diskID, destLinodeID int, timeoutSeconds int,
+) (*InstanceDisk, error) {
+	existing, err := c.ListInstanceDisks(ctx, destLinodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.CloneInstanceAndWait(ctx, srcLinodeID, InstanceCloneOptions{
+		LinodeID: destLinodeID,
+		Disks:    []int{diskID},
+	}, timeoutSeconds); err != nil {
+		return nil, err
+	}
+
+	disks, err := c.ListInstanceDisks(ctx, destLinodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	existingIDs := make(map[int]bool, len(existing))
+	for _, disk := range existing {
+		existingIDs[disk.ID] = true
+	}
+
+	for _, disk := range disks {
+		if !existingIDs[disk.ID] {
+			return &disk, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to find disk cloned from disk %d on Linode %d", diskID, destLinodeID)
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 )
 
-// ListInstanceFirewalls returns a paginated list of Cloud Firewalls for linodeID
+// ListInstanceFirewalls returns a paginated list of the Cloud Firewalls protecting linodeID
 func (c *Client) ListInstanceFirewalls(ctx context.Context, linodeID int, opts *ListOptions) ([]Firewall, error) {
 	return getPaginatedResults[Firewall](ctx, c, formatAPIPath("linode/instances/%d/firewalls", linodeID), opts)
 }
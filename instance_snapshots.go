@@ -3,6 +3,8 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -20,9 +22,18 @@ type InstanceBackupSnapshotResponse struct {
 	InProgress *InstanceSnapshot `json:"in_progress"`
 }
 
+// ErrBackupDoesNotFit is returned by RestoreInstanceBackupAndWait when the target Linode
+// does not have enough disk space available for the disks in the backup.
+var ErrBackupDoesNotFit = errors.New("backup does not fit on the target Linode")
+
 // RestoreInstanceOptions fields are those accepted by InstanceRestore
 type RestoreInstanceOptions struct {
-	LinodeID  int  `json:"linode_id"`
+	// The ID of the Linode to restore the backup to.
+	LinodeID int `json:"linode_id"`
+
+	// If true, all existing disks and configs on the target Linode are deleted
+	// before the backup is restored. Otherwise the restored disks are added
+	// alongside the existing ones and must fit in the Linode's unallocated space.
 	Overwrite bool `json:"overwrite"`
 }
 
@@ -118,8 +129,144 @@ func (c *Client) CancelInstanceBackups(ctx context.Context, linodeID int) error
 	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
 }
 
+// SetInstanceBackupsEnabled enables or cancels backups for the specified Linode. Backups
+// cannot be toggled through UpdateInstance because the API treats backups.enabled as read-only.
+func (c *Client) SetInstanceBackupsEnabled(ctx context.Context, linodeID int, enabled bool) error {
+	if enabled {
+		return c.EnableInstanceBackups(ctx, linodeID)
+	}
+
+	return c.CancelInstanceBackups(ctx, linodeID)
+}
+
+// InstanceBackupScheduleOptions fields are those accepted by UpdateInstanceBackupSchedule
+type InstanceBackupScheduleOptions struct {
+	// The day of the week backups should be taken, e.g. "Sunday", or "Scheduling"
+	// to let the system choose. Leave empty to keep the current day.
+	Day string `json:"day,omitempty"`
+
+	// The two-hour UTC window in which backups should be taken, given as W0, W2, ... W22,
+	// e.g. "W4" for 04:00-06:00 UTC. Leave empty to keep the current window.
+	Window string `json:"window,omitempty"`
+}
+
+var (
+	validBackupScheduleDays = map[string]bool{
+		"Scheduling": true, "Sunday": true, "Monday": true, "Tuesday": true,
+		"Wednesday": true, "Thursday": true, "Friday": true, "Saturday": true,
+	}
+	validBackupScheduleWindows = map[string]bool{
+		"W0": true, "W2": true, "W4": true, "W6": true, "W8": true, "W10": true,
+		"W12": true, "W14": true, "W16": true, "W18": true, "W20": true, "W22": true,
+	}
+)
+
+// UpdateInstanceBackupSchedule sets the day and window during which the specified Linode's
+// backups are taken, returning the Linode's updated backup settings.
+// The Day and Window are validated before any request is made.
+func (c *Client) UpdateInstanceBackupSchedule(ctx context.Context, linodeID int, opts InstanceBackupScheduleOptions) (*InstanceBackup, error) {
+	if opts.Day != "" && !validBackupScheduleDays[opts.Day] {
+		return nil, fmt.Errorf("invalid backup schedule day %q", opts.Day)
+	}
+
+	if opts.Window != "" && !validBackupScheduleWindows[opts.Window] {
+		return nil, fmt.Errorf("invalid backup schedule window %q: must be one of W0, W2, ... W22", opts.Window)
+	}
+
+	backups := &InstanceBackup{}
+	backups.Schedule.Day = opts.Day
+	backups.Schedule.Window = opts.Window
+
+	instance, err := c.UpdateInstance(ctx, linodeID, InstanceUpdateOptions{Backups: backups})
+	if err != nil {
+		return nil, err
+	}
+
+	return instance.Backups, nil
+}
+
 // RestoreInstanceBackup Restores a Linode's Backup to the specified Linode.
 func (c *Client) RestoreInstanceBackup(ctx context.Context, linodeID int, backupID int, opts RestoreInstanceOptions) error {
 	e := formatAPIPath("linode/instances/%d/backups/%d/restore", linodeID, backupID)
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
+
+// RestoreInstanceBackupAndWait restores a Linode's Backup to the Linode specified in opts
+// and waits for the restore to complete, returning the finished backups_restore event.
+// Before restoring, the size of the backup is checked against the space available on the
+// target Linode, and ErrBackupDoesNotFit is returned if the backup's disks cannot fit.
+func (c *Client) RestoreInstanceBackupAndWait(
+	ctx context.Context, linodeID int, backupID int, opts RestoreInstanceOptions, timeoutSeconds int,
+) (*Event, error) {
+	if err := c.checkBackupFits(ctx, linodeID, backupID, opts); err != nil {
+		return nil, err
+	}
+
+	poller, err := c.NewEventPoller(ctx, opts.LinodeID, EntityLinode, ActionBackupsRestore)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.RestoreInstanceBackup(ctx, linodeID, backupID, opts); err != nil {
+		return nil, err
+	}
+
+	event, err := poller.WaitForFinished(ctx, timeoutSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for backup %d to be restored to Linode %d: %w", backupID, opts.LinodeID, err)
+	}
+
+	return event, nil
+}
+
+// RestoreInstanceBackupToNew creates a new Linode from the Backup with the provided ID.
+// Unlike RestoreInstanceBackup, no existing Linode is modified; opts must include the
+// Region and Type of the new Linode, and the Type must have enough disk for the backup.
+// The returned Instance is provisioning; use WaitForInstanceStatus to wait for it to be ready.
+func (c *Client) RestoreInstanceBackupToNew(ctx context.Context, backupID int, opts InstanceCreateOptions) (*Instance, error) {
+	opts.BackupID = backupID
+	return c.CreateInstance(ctx, opts)
+}
+
+func (c *Client) checkBackupFits(ctx context.Context, linodeID int, backupID int, opts RestoreInstanceOptions) error {
+	backup, err := c.GetInstanceSnapshot(ctx, linodeID, backupID)
+	if err != nil {
+		return err
+	}
+
+	target, err := c.GetInstance(ctx, opts.LinodeID)
+	if err != nil {
+		return err
+	}
+
+	if target.Specs == nil {
+		return nil
+	}
+
+	required := 0
+	for _, disk := range backup.Disks {
+		required += disk.Size
+	}
+
+	available := target.Specs.Disk
+
+	if !opts.Overwrite {
+		disks, err := c.ListInstanceDisks(ctx, opts.LinodeID, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, disk := range disks {
+			available -= disk.Size
+		}
+	}
+
+	if required > available {
+		return fmt.Errorf(
+			"%w: backup %d requires %d MB but Linode %d has %d MB available",
+			ErrBackupDoesNotFit, backupID, required, opts.LinodeID, available,
+		)
+	}
+
+	return nil
+}
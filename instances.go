@@ -3,7 +3,13 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
 	"net"
+	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -39,6 +45,14 @@ const (
 	ColdMigration InstanceMigrationType = "cold"
 )
 
+// ErrWarmMigrationUnsupported is returned (wrapped alongside the underlying API error)
+// by MigrateInstance when a warm migration was requested but rejected by the API.
+// Callers may check for it with errors.Is and retry using ColdMigration.
+var ErrWarmMigrationUnsupported = errors.New("warm migration is not supported for this instance")
+
+// warmMigrationUnsupportedReason is the lowercase reason given by the API when it rejects a warm migration.
+const warmMigrationUnsupportedReason = "warm migrations are not available"
+
 // Instance represents a linode object
 type Instance struct {
 	ID              int             `json:"id"`
@@ -207,13 +221,97 @@ type InstanceCreatePlacementGroupOptions struct {
 	CompliantOnly *bool `json:"compliant_only,omitempty"`
 }
 
+// Validate reports missing regions and types, conflicting image and backup options, and unknown interface purposes.
+// Account and API policies, such as password requirements, are left for the API to enforce.
+func (o InstanceCreateOptions) Validate() error {
+	var errs []error
+
+	if o.Region == "" {
+		errs = append(errs, errors.New("region is required"))
+	}
+
+	if o.Type == "" {
+		errs = append(errs, errors.New("type is required"))
+	}
+
+	if o.Image != "" && o.BackupID != 0 {
+		errs = append(errs, errors.New("image and backup_id cannot both be set"))
+	}
+
+	if o.StackScriptID != 0 && o.Image == "" {
+		errs = append(errs, errors.New("image is required when stackscript_id is set"))
+	}
+
+	switch o.DiskEncryption {
+	case "", InstanceDiskEncryptionEnabled, InstanceDiskEncryptionDisabled:
+	default:
+		errs = append(errs, fmt.Errorf("unknown disk_encryption %q", o.DiskEncryption))
+	}
+
+	for i, iface := range o.Interfaces {
+		switch iface.Purpose {
+		case InterfacePurposePublic:
+		case InterfacePurposeVLAN:
+			if iface.Label == "" {
+				errs = append(errs, fmt.Errorf("interface %d: label is required for vlan interfaces", i))
+			}
+		case InterfacePurposeVPC:
+			if iface.SubnetID == nil {
+				errs = append(errs, fmt.Errorf("interface %d: subnet_id is required for vpc interfaces", i))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("interface %d: unknown purpose %q", i, iface.Purpose))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Clone returns a deep copy of the InstanceCreateOptions. Slices, maps and pointers are
+// copied, so the clone can be modified without affecting the original, e.g. when using
+// one InstanceCreateOptions as a template for concurrent creates.
+func (o InstanceCreateOptions) Clone() InstanceCreateOptions {
+	o.AuthorizedKeys = slices.Clone(o.AuthorizedKeys)
+	o.AuthorizedUsers = slices.Clone(o.AuthorizedUsers)
+	o.StackScriptData = maps.Clone(o.StackScriptData)
+	o.Tags = slices.Clone(o.Tags)
+	o.IPv4 = slices.Clone(o.IPv4)
+	o.Metadata = copyPtr(o.Metadata)
+	o.SwapSize = copyInt(o.SwapSize)
+	o.Booted = copyBool(o.Booted)
+
+	if o.Interfaces != nil {
+		interfaces := make([]InstanceConfigInterfaceCreateOptions, len(o.Interfaces))
+		for i, iface := range o.Interfaces {
+			interfaces[i] = iface.Clone()
+		}
+
+		o.Interfaces = interfaces
+	}
+
+	if o.PlacementGroup != nil {
+		pg := *o.PlacementGroup
+		pg.CompliantOnly = copyBool(pg.CompliantOnly)
+		o.PlacementGroup = &pg
+	}
+
+	return o
+}
+
 // InstanceUpdateOptions is an options struct used when Updating an Instance
 type InstanceUpdateOptions struct {
-	Label           string          `json:"label,omitempty"`
-	Backups         *InstanceBackup `json:"backups,omitempty"`
-	Alerts          *InstanceAlert  `json:"alerts,omitempty"`
-	WatchdogEnabled *bool           `json:"watchdog_enabled,omitempty"`
-	Tags            *[]string       `json:"tags,omitempty"`
+	Label *Null[string] `json:"label,omitempty"`
+
+	// Backups.Enabled is read-only, so only the backup schedule is changed by UpdateInstance.
+	// Use SetInstanceBackupsEnabled to enable or cancel backups.
+	Backups *InstanceBackup `json:"backups,omitempty"`
+
+	Alerts          *InstanceAlert `json:"alerts,omitempty"`
+	WatchdogEnabled *bool          `json:"watchdog_enabled,omitempty"`
+
+	// Tags replaces all tags on the Instance. A pointer to an empty slice removes
+	// all tags, while a nil pointer leaves them unchanged.
+	Tags *[]string `json:"tags,omitempty"`
 
 	// Deprecated: group is a deprecated property denoting a group label for the Linode.
 	Group *string `json:"group,omitempty"`
@@ -264,7 +362,7 @@ func (backup *InstanceBackup) UnmarshalJSON(b []byte) error {
 // GetUpdateOptions converts an Instance to InstanceUpdateOptions for use in UpdateInstance
 func (i *Instance) GetUpdateOptions() InstanceUpdateOptions {
 	return InstanceUpdateOptions{
-		Label:           i.Label,
+		Label:           NullOf(i.Label),
 		Group:           &i.Group,
 		Backups:         i.Backups,
 		Alerts:          i.Alerts,
@@ -278,12 +376,17 @@ type InstanceCloneOptions struct {
 	Region string `json:"region,omitempty"`
 	Type   string `json:"type,omitempty"`
 
-	// LinodeID is an optional existing instance to use as the target of the clone
-	LinodeID       int                                  `json:"linode_id,omitempty"`
-	Label          string                               `json:"label,omitempty"`
-	BackupsEnabled bool                                 `json:"backups_enabled"`
-	Disks          []int                                `json:"disks,omitempty"`
-	Configs        []int                                `json:"configs,omitempty"`
+	// LinodeID is an optional existing instance to use as the target of the clone.
+	// Region and Type must not be set when cloning into an existing instance.
+	LinodeID       int    `json:"linode_id,omitempty"`
+	Label          string `json:"label,omitempty"`
+	BackupsEnabled bool   `json:"backups_enabled"`
+
+	// Disks and Configs optionally restrict the clone to the given disk and config IDs
+	// of the source instance. If neither is set, all disks and configs are cloned.
+	Disks   []int `json:"disks,omitempty"`
+	Configs []int `json:"configs,omitempty"`
+
 	PrivateIP      bool                                 `json:"private_ip,omitempty"`
 	Metadata       *InstanceMetadataOptions             `json:"metadata,omitempty"`
 	PlacementGroup *InstanceCreatePlacementGroupOptions `json:"placement_group,omitempty"`
@@ -315,6 +418,41 @@ func (c *Client) ListInstances(ctx context.Context, opts *ListOptions) ([]Instan
 	return getPaginatedResults[Instance](ctx, c, "linode/instances", opts)
 }
 
+// StreamInstances streams every linode instance over the returned channel.
+// See StreamAll for details on channel semantics.
+func StreamInstances(ctx context.Context, client *Client, opts *ListOptions) (<-chan Instance, <-chan error) {
+	return StreamAll[Instance](ctx, client, "linode/instances", opts)
+}
+
+// InstancesIterator iterates over every Instance on the account,
+// transparently requesting subsequent pages as they are needed.
+type InstancesIterator struct {
+	iter *pageIterator[Instance]
+}
+
+// NewInstancesIterator creates an InstancesIterator using the given ListOptions.
+// The PageSize and Filter of opts are respected. If opts specifies a Page,
+// iteration begins at that page.
+func NewInstancesIterator(client *Client, opts *ListOptions) *InstancesIterator {
+	return &InstancesIterator{iter: newPageIterator[Instance](client, "linode/instances", opts)}
+}
+
+// Next advances the iterator to the next Instance, returning false when
+// there are no more Instances or an error has occurred.
+func (it *InstancesIterator) Next(ctx context.Context) bool {
+	return it.iter.next(ctx)
+}
+
+// Instance returns the Instance at the current position of the iterator.
+func (it *InstancesIterator) Instance() Instance {
+	return it.iter.value()
+}
+
+// Err returns the first error encountered during iteration, if any.
+func (it *InstancesIterator) Err() error {
+	return it.iter.err
+}
+
 // GetInstance gets the instance with the provided ID
 func (c *Client) GetInstance(ctx context.Context, linodeID int) (*Instance, error) {
 	e := formatAPIPath("linode/instances/%d", linodeID)
@@ -329,16 +467,32 @@ func (c *Client) GetInstanceTransfer(ctx context.Context, linodeID int) (*Instan
 
 // GetInstanceTransferMonthly gets the instance's network transfer pool statistics for a specific month.
 func (c *Client) GetInstanceTransferMonthly(ctx context.Context, linodeID, year, month int) (*MonthlyInstanceTransferStats, error) {
+	if err := validateTransferMonth(month); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("linode/instances/%d/transfer/%d/%d", linodeID, year, month)
 	return doGETRequest[MonthlyInstanceTransferStats](ctx, c, e)
 }
 
 // GetInstanceTransferMonthlyV2 gets the instance's network transfer pool statistics for a specific month.
 func (c *Client) GetInstanceTransferMonthlyV2(ctx context.Context, linodeID, year, month int) (*MonthlyInstanceTransferStatsV2, error) {
+	if err := validateTransferMonth(month); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("linode/instances/%d/transfer/%d/%d", linodeID, year, month)
 	return doGETRequest[MonthlyInstanceTransferStatsV2](ctx, c, e)
 }
 
+func validateTransferMonth(month int) error {
+	if month < 1 || month > 12 {
+		return fmt.Errorf("invalid month %d: must be between 1 and 12", month)
+	}
+
+	return nil
+}
+
 // CreateInstance creates a Linode instance
 func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions) (*Instance, error) {
 	return doPOSTRequest[Instance](ctx, c, "linode/instances", opts)
@@ -352,7 +506,7 @@ func (c *Client) UpdateInstance(ctx context.Context, linodeID int, opts Instance
 
 // RenameInstance renames an Instance
 func (c *Client) RenameInstance(ctx context.Context, linodeID int, label string) (*Instance, error) {
-	return c.UpdateInstance(ctx, linodeID, InstanceUpdateOptions{Label: label})
+	return c.UpdateInstance(ctx, linodeID, InstanceUpdateOptions{Label: NullOf(label)})
 }
 
 // DeleteInstance deletes a Linode instance
@@ -436,12 +590,147 @@ func (c *Client) RescueInstance(ctx context.Context, linodeID int, opts Instance
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
+// NewRescueDeviceMap builds the device map for RescueInstance, assigning the given
+// disk IDs to SDA through SDH in order. At most 8 disks may be provided.
+func NewRescueDeviceMap(diskIDs ...int) (InstanceConfigDeviceMap, error) {
+	var devices InstanceConfigDeviceMap
+
+	slots := []**InstanceConfigDevice{
+		&devices.SDA, &devices.SDB, &devices.SDC, &devices.SDD,
+		&devices.SDE, &devices.SDF, &devices.SDG, &devices.SDH,
+	}
+
+	if len(diskIDs) > len(slots) {
+		return devices, fmt.Errorf("at most %d devices may be mapped in rescue mode, got %d", len(slots), len(diskIDs))
+	}
+
+	for i, id := range diskIDs {
+		*slots[i] = &InstanceConfigDevice{DiskID: id}
+	}
+
+	return devices, nil
+}
+
+// RescueInstanceWithDisks reboots an instance into Rescue Mode with the given disks mapped to
+// SDA through SDH in order, and returns the linode_rescue event it triggered. The disks are
+// verified to belong to the instance and to not be swap disks before the rescue is requested.
+// It will timeout with an error if the event is not seen within timeoutSeconds. Use
+// WaitForEventFinished with ActionLinodeRescue to wait for the rescue boot to complete.
+func (c *Client) RescueInstanceWithDisks(
+	ctx context.Context, linodeID int, timeoutSeconds int, diskIDs ...int,
+) (*Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	devices, err := NewRescueDeviceMap(diskIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	disks, err := c.ListInstanceDisks(ctx, linodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	filesystems := make(map[int]DiskFilesystem, len(disks))
+	for _, disk := range disks {
+		filesystems[disk.ID] = disk.Filesystem
+	}
+
+	for _, id := range diskIDs {
+		fs, ok := filesystems[id]
+		if !ok {
+			return nil, fmt.Errorf("disk %d does not belong to instance %d", id, linodeID)
+		}
+
+		if fs == FilesystemSwap {
+			return nil, fmt.Errorf("disk %d is a swap disk and cannot be mapped in rescue mode", id)
+		}
+	}
+
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionLinodeRescue)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.RescueInstance(ctx, linodeID, InstanceRescueOptions{Devices: devices}); err != nil {
+		return nil, err
+	}
+
+	event, err := poller.WaitForLatestUnknownEvent(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the rescue event for instance %d: %w", linodeID, err)
+	}
+
+	return event, nil
+}
+
 // ResizeInstance resizes an instance to new Linode type
 func (c *Client) ResizeInstance(ctx context.Context, linodeID int, opts InstanceResizeOptions) error {
 	e := formatAPIPath("linode/instances/%d/resize", linodeID)
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
+// ResizeInstanceChecked verifies that opts.Type exists and is available in the instance's region
+// before resizing the instance, returning a descriptive error instead of starting a resize that
+// cannot succeed. If timeoutSeconds is greater than zero, it also waits for the resulting
+// linode_resize event to finish. The refreshed Instance is returned.
+func (c *Client) ResizeInstanceChecked(
+	ctx context.Context, linodeID int, opts InstanceResizeOptions, timeoutSeconds int,
+) (*Instance, error) {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.GetType(ctx, opts.Type); err != nil {
+		if ErrHasStatus(err, http.StatusNotFound) {
+			return nil, fmt.Errorf("linode type %q does not exist", opts.Type)
+		}
+
+		return nil, err
+	}
+
+	filter, err := NewFilter().Eq("region", instance.Region).Eq("plan", opts.Type).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	availability, err := c.ListRegionsAvailability(ctx, NewListOptions(0, filter))
+	if err != nil {
+		return nil, err
+	}
+
+	// Plans without an availability entry for the region cannot be resized to either
+	available := slices.ContainsFunc(availability, func(a RegionAvailability) bool {
+		return a.Region == instance.Region && a.Plan == opts.Type && a.Available
+	})
+	if !available {
+		return nil, fmt.Errorf("linode type %q is not available in region %s", opts.Type, instance.Region)
+	}
+
+	var poller *EventPoller
+
+	if timeoutSeconds > 0 {
+		poller, err = c.NewEventPoller(ctx, linodeID, EntityLinode, ActionLinodeResize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.ResizeInstance(ctx, linodeID, opts); err != nil {
+		return nil, err
+	}
+
+	if poller != nil {
+		if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+			return nil, fmt.Errorf("failed to wait for instance %d to resize: %w", linodeID, err)
+		}
+	}
+
+	return c.GetInstance(ctx, linodeID)
+}
+
 // ShutdownInstance - Shutdown an instance
 func (c *Client) ShutdownInstance(ctx context.Context, id int) error {
 	return c.simpleInstanceAction(ctx, "shutdown", id)
@@ -467,9 +756,138 @@ func (c *Client) UpgradeInstance(ctx context.Context, linodeID int, opts Instanc
 }
 
 // MigrateInstance - Migrate an instance
+//
+// If opts.Type is WarmMigration and the API rejects the request because warm
+// migrations are unavailable for the instance, the returned error wraps
+// ErrWarmMigrationUnsupported.
 func (c *Client) MigrateInstance(ctx context.Context, linodeID int, opts InstanceMigrateOptions) error {
 	e := formatAPIPath("linode/instances/%d/migrate", linodeID)
-	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
+
+	err := doPOSTRequestNoResponseBody(ctx, c, e, opts)
+	if err != nil && opts.Type == WarmMigration && isWarmMigrationUnsupported(err) {
+		return fmt.Errorf("%w: %w", ErrWarmMigrationUnsupported, err)
+	}
+
+	return err
+}
+
+// isWarmMigrationUnsupported returns whether err is the API's rejection of a warm migration,
+// which is reported against the type field.
+func isWarmMigrationUnsupported(err error) bool {
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode() != http.StatusBadRequest {
+		return false
+	}
+
+	return slices.ContainsFunc(e.Reasons(), func(r APIErrorReason) bool {
+		return r.Field == "type" && strings.Contains(strings.ToLower(r.Reason), warmMigrationUnsupportedReason)
+	})
+}
+
+// MigrateInstanceAndWait migrates an instance and waits for the resulting migration
+// event to finish before returning the refreshed Instance. Migrations to another region
+// are tracked through the linode_migrate_datacenter event, all others through linode_migrate.
+// It will timeout with an error after timeoutSeconds.
+func (c *Client) MigrateInstanceAndWait(
+	ctx context.Context, linodeID int, opts InstanceMigrateOptions, timeoutSeconds int,
+) (*Instance, error) {
+	action := ActionLinodeMigrate
+	if opts.Region != "" {
+		action = ActionLinodeMigrateDatacenter
+	}
+
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.MigrateInstance(ctx, linodeID, opts); err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for instance %d to migrate: %w", linodeID, err)
+	}
+
+	return c.GetInstance(ctx, linodeID)
+}
+
+// CloneInstanceAndWait clones an instance and waits for the resulting linode_clone
+// event to finish before returning the refreshed target Instance.
+// It will timeout with an error after timeoutSeconds.
+func (c *Client) CloneInstanceAndWait(
+	ctx context.Context, linodeID int, opts InstanceCloneOptions, timeoutSeconds int,
+) (*Instance, error) {
+	if opts.LinodeID != 0 && (opts.Region != "" || opts.Type != "") {
+		return nil, fmt.Errorf("region and type cannot be specified when cloning into existing instance %d", opts.LinodeID)
+	}
+
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionLinodeClone)
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := c.CloneInstance(ctx, linodeID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	poller.SecondaryEntityID = clone.ID
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for instance %d to be cloned to %d: %w", linodeID, clone.ID, err)
+	}
+
+	return c.GetInstance(ctx, clone.ID)
+}
+
+// BootInstanceAndWait boots a Linode instance and waits for the resulting linode_boot
+// event to finish before returning the refreshed Instance.
+// A configID of 0 will cause Linode to choose the last/best config.
+// It will timeout with an error after timeoutSeconds.
+func (c *Client) BootInstanceAndWait(ctx context.Context, linodeID int, configID int, timeoutSeconds int) (*Instance, error) {
+	return c.instanceActionAndWait(ctx, linodeID, ActionLinodeBoot, timeoutSeconds, func() error {
+		return c.BootInstance(ctx, linodeID, configID)
+	})
+}
+
+// RebootInstanceAndWait reboots a Linode instance and waits for the resulting linode_reboot
+// event to finish before returning the refreshed Instance.
+// A configID of 0 will cause Linode to choose the last/best config.
+// It will timeout with an error after timeoutSeconds.
+func (c *Client) RebootInstanceAndWait(ctx context.Context, linodeID int, configID int, timeoutSeconds int) (*Instance, error) {
+	return c.instanceActionAndWait(ctx, linodeID, ActionLinodeReboot, timeoutSeconds, func() error {
+		return c.RebootInstance(ctx, linodeID, configID)
+	})
+}
+
+// ShutdownInstanceAndWait shuts down a Linode instance and waits for the resulting
+// linode_shutdown event to finish before returning the refreshed Instance.
+// It will timeout with an error after timeoutSeconds.
+func (c *Client) ShutdownInstanceAndWait(ctx context.Context, linodeID int, timeoutSeconds int) (*Instance, error) {
+	return c.instanceActionAndWait(ctx, linodeID, ActionLinodeShutdown, timeoutSeconds, func() error {
+		return c.ShutdownInstance(ctx, linodeID)
+	})
+}
+
+// instanceActionAndWait runs the given Instance action and waits for its event to finish
+func (c *Client) instanceActionAndWait(
+	ctx context.Context, linodeID int, action EventAction, timeoutSeconds int, run func() error,
+) (*Instance, error) {
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := run(); err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for instance %d %s event: %w", linodeID, action, err)
+	}
+
+	return c.GetInstance(ctx, linodeID)
 }
 
 // simpleInstanceAction is a helper for Instance actions that take no parameters
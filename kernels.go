@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -21,6 +22,34 @@ type LinodeKernel struct {
 	Built        *time.Time `json:"-"`
 }
 
+// KernelFilter selects kernels by their KVM support, deprecation and architecture.
+// Nil or empty fields are not filtered on.
+type KernelFilter struct {
+	KVM          *bool
+	Deprecated   *bool
+	Architecture string
+}
+
+// Build serializes the KernelFilter into the X-Filter string expected by ListOptions.Filter,
+// e.g. c.ListKernels(ctx, NewListOptions(0, filter)).
+func (f KernelFilter) Build() (string, error) {
+	filter := NewFilter()
+
+	if f.Architecture != "" {
+		filter.Eq("architecture", f.Architecture)
+	}
+
+	if f.Deprecated != nil {
+		filter.Eq("deprecated", *f.Deprecated)
+	}
+
+	if f.KVM != nil {
+		filter.Eq("kvm", *f.KVM)
+	}
+
+	return filter.Build()
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *LinodeKernel) UnmarshalJSON(b []byte) error {
 	type Mask LinodeKernel
@@ -48,7 +77,7 @@ func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKe
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
 		return result.([]LinodeKernel), nil
 	}
 
@@ -57,7 +86,7 @@ func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKe
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, nil)
+	c.addCachedResponse(ctx, endpoint, response, nil)
 
 	return response, nil
 }
@@ -66,7 +95,7 @@ func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKe
 func (c *Client) GetKernel(ctx context.Context, kernelID string) (*LinodeKernel, error) {
 	e := formatAPIPath("linode/kernels/%s", kernelID)
 
-	if result := c.getCachedResponse(e); result != nil {
+	if result := c.getCachedResponse(ctx, e); result != nil {
 		result := result.(LinodeKernel)
 		return &result, nil
 	}
@@ -76,7 +105,57 @@ func (c *Client) GetKernel(ctx context.Context, kernelID string) (*LinodeKernel,
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, nil)
+	c.addCachedResponse(ctx, e, response, nil)
 
 	return response, nil
 }
+
+// FilterKernels returns the kernels for which pred returns true.
+func FilterKernels(kernels []LinodeKernel, pred func(LinodeKernel) bool) []LinodeKernel {
+	result := make([]LinodeKernel, 0, len(kernels))
+
+	for _, kernel := range kernels {
+		if pred(kernel) {
+			result = append(result, kernel)
+		}
+	}
+
+	return result
+}
+
+// GetLatestKernel gets the most recently built non-deprecated KVM kernel for the
+// given architecture, e.g. "x86_64" or "i386". This avoids hardcoding kernel IDs
+// that may later be deprecated.
+func (c *Client) GetLatestKernel(ctx context.Context, arch string) (*LinodeKernel, error) {
+	f, err := KernelFilter{
+		KVM:          Pointer(true),
+		Deprecated:   Pointer(false),
+		Architecture: arch,
+	}.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	kernels, err := c.ListKernels(ctx, NewListOptions(0, f))
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *LinodeKernel
+
+	for i, kernel := range kernels {
+		if kernel.Built == nil {
+			continue
+		}
+
+		if latest == nil || kernel.Built.After(*latest.Built) {
+			latest = &kernels[i]
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("no non-deprecated kernels found for architecture %q", arch)
+	}
+
+	return latest, nil
+}
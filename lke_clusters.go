@@ -3,12 +3,24 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// ErrLKEUpgradeNotAllowed is returned by UpgradeLKEClusterVersion when the target
+// Kubernetes version is not a valid upgrade for the cluster.
+var ErrLKEUpgradeNotAllowed = errors.New("lke cluster version upgrade not allowed")
+
+// ErrLKEEnterpriseOnly is returned when an option that is only supported by
+// LKE Enterprise clusters is used with another tier.
+var ErrLKEEnterpriseOnly = errors.New("option is only available for LKE Enterprise clusters")
+
 // LKEClusterStatus represents the status of an LKECluster
 type LKEClusterStatus string
 
@@ -103,6 +115,85 @@ type LKEClusterRegenerateOptions struct {
 	ServiceToken bool `json:"servicetoken"`
 }
 
+// Clone returns a deep copy of the LKEClusterCreateOptions, including its node pools.
+func (o LKEClusterCreateOptions) Clone() LKEClusterCreateOptions {
+	o.Tags = slices.Clone(o.Tags)
+
+	if o.NodePools != nil {
+		pools := make([]LKENodePoolCreateOptions, len(o.NodePools))
+		for i, pool := range o.NodePools {
+			pools[i] = pool.Clone()
+		}
+
+		o.NodePools = pools
+	}
+
+	if o.ControlPlane != nil {
+		controlPlane := o.ControlPlane.Clone()
+		o.ControlPlane = &controlPlane
+	}
+
+	return o
+}
+
+// Validate reports missing fields and node pools and unknown tiers.
+// Node counts and tier-specific options are left for the API and CreateLKECluster to enforce.
+func (o LKEClusterCreateOptions) Validate() error {
+	var errs []error
+
+	if o.Label == "" {
+		errs = append(errs, errors.New("label is required"))
+	}
+
+	if o.Region == "" {
+		errs = append(errs, errors.New("region is required"))
+	}
+
+	if o.K8sVersion == "" {
+		errs = append(errs, errors.New("k8s_version is required"))
+	}
+
+	if len(o.NodePools) == 0 {
+		errs = append(errs, errors.New("at least one node pool is required"))
+	}
+
+	switch LKEVersionTier(o.Tier) {
+	case "", LKEVersionStandard, LKEVersionEnterprise:
+	default:
+		errs = append(errs, fmt.Errorf("unknown tier %q", o.Tier))
+	}
+
+	for i, pool := range o.NodePools {
+		if pool.Type == "" {
+			errs = append(errs, fmt.Errorf("node pool %d: type is required", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateEnterpriseOnly checks that options only supported by LKE Enterprise are not
+// used when Tier is not LKEVersionEnterprise. It is called by CreateLKECluster.
+func (o LKEClusterCreateOptions) validateEnterpriseOnly() error {
+	if o.Tier == string(LKEVersionEnterprise) {
+		return nil
+	}
+
+	var errs []error
+
+	for i, pool := range o.NodePools {
+		if pool.K8sVersion != nil {
+			errs = append(errs, fmt.Errorf("%w: node pool %d sets k8s_version", ErrLKEEnterpriseOnly, i))
+		}
+
+		if pool.UpdateStrategy != nil {
+			errs = append(errs, fmt.Errorf("%w: node pool %d sets update_strategy", ErrLKEEnterpriseOnly, i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *LKECluster) UnmarshalJSON(b []byte) error {
 	type Mask LKECluster
@@ -131,6 +222,7 @@ func (i LKECluster) GetCreateOptions() (o LKEClusterCreateOptions) {
 	o.Region = i.Region
 	o.K8sVersion = i.K8sVersion
 	o.Tags = i.Tags
+	o.Tier = i.Tier
 
 	isHA := i.ControlPlane.HighAvailability
 
@@ -168,7 +260,7 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
 		return result.([]LKEVersion), nil
 	}
 
@@ -177,7 +269,7 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -186,7 +278,7 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 func (c *Client) GetLKEVersion(ctx context.Context, version string) (*LKEVersion, error) {
 	e := formatAPIPath("lke/versions/%s", version)
 
-	if result := c.getCachedResponse(e); result != nil {
+	if result := c.getCachedResponse(ctx, e); result != nil {
 		result := result.(LKEVersion)
 		return &result, nil
 	}
@@ -196,7 +288,7 @@ func (c *Client) GetLKEVersion(ctx context.Context, version string) (*LKEVersion
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, e, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -231,6 +323,10 @@ func (c *Client) GetLKECluster(ctx context.Context, clusterID int) (*LKECluster,
 
 // CreateLKECluster creates a LKECluster
 func (c *Client) CreateLKECluster(ctx context.Context, opts LKEClusterCreateOptions) (*LKECluster, error) {
+	if err := opts.validateEnterpriseOnly(); err != nil {
+		return nil, err
+	}
+
 	return doPOSTRequest[LKECluster](ctx, c, "lke/clusters", opts)
 }
 
@@ -252,7 +348,11 @@ func (c *Client) GetLKEClusterKubeconfig(ctx context.Context, clusterID int) (*L
 	return doGETRequest[LKEClusterKubeconfig](ctx, c, e)
 }
 
-// DeleteLKEClusterKubeconfig deletes the Kubeconfig for the LKE Cluster specified
+// DeleteLKEClusterKubeconfig deletes the Kubeconfig for the LKE Cluster specified.
+// A new Kubeconfig is generated automatically. While it is being generated the API
+// responds to GetLKEClusterKubeconfig with 503 Service Unavailable, which is retried
+// by the default retry conditions configured in SetRetries.
+// Use RegenerateLKECluster to explicitly regenerate the Kubeconfig.
 func (c *Client) DeleteLKEClusterKubeconfig(ctx context.Context, clusterID int) error {
 	e := formatAPIPath("lke/clusters/%d/kubeconfig", clusterID)
 	return doDELETERequest(ctx, c, e)
@@ -264,10 +364,20 @@ func (c *Client) GetLKEClusterDashboard(ctx context.Context, clusterID int) (*LK
 	return doGETRequest[LKEClusterDashboard](ctx, c, e)
 }
 
-// RecycleLKEClusterNodes recycles all nodes in all pools of the specified LKE Cluster.
-func (c *Client) RecycleLKEClusterNodes(ctx context.Context, clusterID int) error {
+// RecycleLKEClusterNodes recycles all nodes in all pools of the specified LKE Cluster,
+// returning the IDs of the nodes being replaced.
+func (c *Client) RecycleLKEClusterNodes(ctx context.Context, clusterID int) ([]string, error) {
+	ids, err := c.ListLKEClusterNodeIDs(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("lke/clusters/%d/recycle", clusterID)
-	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
+	if err := doPOSTRequestNoRequestResponseBody(ctx, c, e); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
 }
 
 // RegenerateLKECluster regenerates the Kubeconfig file and/or the service account token for the specified LKE Cluster.
@@ -309,3 +419,156 @@ func (c *Client) GetLKEClusterAPLHealthCheckURL(ctx context.Context, clusterID i
 
 	return "", nil
 }
+
+// UpgradeLKEClusterVersion upgrades the Kubernetes version of the specified LKE Cluster.
+// The target version must be offered by LKE for the cluster's tier and must be either the
+// next minor version after the cluster's current version, since LKE does not support skipping
+// minor versions, or a later patch or LKE release of the current minor version. When
+// recycleNodes is true, all nodes are recycled after the upgrade so that they run the new
+// kubelet version.
+func (c *Client) UpgradeLKEClusterVersion(
+	ctx context.Context,
+	clusterID int,
+	targetVersion string,
+	recycleNodes bool,
+) (*LKECluster, error) {
+	cluster, err := c.GetLKECluster(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	available, err := c.listLKEVersionIDs(ctx, cluster.Tier)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(available, targetVersion) {
+		return nil, fmt.Errorf("%w: version %s is not available, available versions are %s",
+			ErrLKEUpgradeNotAllowed, targetVersion, strings.Join(available, ", "))
+	}
+
+	if err := checkLKEVersionUpgrade(cluster.K8sVersion, targetVersion); err != nil {
+		return nil, err
+	}
+
+	cluster, err = c.UpdateLKECluster(ctx, clusterID, LKEClusterUpdateOptions{
+		K8sVersion: targetVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if recycleNodes {
+		if _, err := c.RecycleLKEClusterNodes(ctx, clusterID); err != nil {
+			return nil, fmt.Errorf("failed to recycle nodes after upgrading LKE cluster %d: %w", clusterID, err)
+		}
+	}
+
+	return cluster, nil
+}
+
+// listLKEVersionIDs lists the IDs of the Kubernetes versions available for the given tier.
+func (c *Client) listLKEVersionIDs(ctx context.Context, tier string) ([]string, error) {
+	if tier != "" && tier != string(LKEVersionStandard) {
+		versions, err := c.ListLKETierVersions(ctx, tier, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]string, len(versions))
+		for i, v := range versions {
+			ids[i] = v.ID
+		}
+
+		return ids, nil
+	}
+
+	versions, err := c.ListLKEVersions(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(versions))
+	for i, v := range versions {
+		ids[i] = v.ID
+	}
+
+	return ids, nil
+}
+
+// checkLKEVersionUpgrade ensures that target is the next minor version after current,
+// or a later patch or LKE release of the current minor version.
+func checkLKEVersionUpgrade(current, target string) error {
+	from, err := parseLKEVersion(current)
+	if err != nil {
+		return err
+	}
+
+	to, err := parseLKEVersion(target)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case to.major != from.major || to.minor < from.minor:
+		return fmt.Errorf("%w: cannot upgrade from %s to %s", ErrLKEUpgradeNotAllowed, current, target)
+	case to.minor == from.minor:
+		if to == from {
+			return fmt.Errorf("%w: cluster is already running %s", ErrLKEUpgradeNotAllowed, current)
+		}
+
+		if to.patch < from.patch || (to.patch == from.patch && to.release < from.release) {
+			return fmt.Errorf("%w: cannot upgrade from %s to %s", ErrLKEUpgradeNotAllowed, current, target)
+		}
+	case to.minor > from.minor+1:
+		return fmt.Errorf(
+			"%w: cannot upgrade from %s to %s, minor versions must be upgraded one at a time (next version is %d.%d)",
+			ErrLKEUpgradeNotAllowed, current, target, from.major, from.minor+1,
+		)
+	}
+
+	return nil
+}
+
+// lkeVersion is a parsed LKE Kubernetes version. The patch and release are zero
+// when they are not part of the version, as in "1.31".
+type lkeVersion struct {
+	major, minor, patch int
+
+	// release is the N in an LKE release suffix such as "+lkeN"
+	release int
+}
+
+// parseLKEVersion parses an LKE Kubernetes version such as "1.31" or "v1.31.8+lke1".
+func parseLKEVersion(version string) (lkeVersion, error) {
+	var v lkeVersion
+
+	invalid := fmt.Errorf("invalid LKE version %q", version)
+
+	base, suffix, hasSuffix := strings.Cut(strings.TrimPrefix(version, "v"), "+")
+
+	parts := strings.Split(base, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v, invalid
+	}
+
+	for i, field := range []*int{&v.major, &v.minor, &v.patch}[:len(parts)] {
+		value, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return v, invalid
+		}
+
+		*field = value
+	}
+
+	if hasSuffix {
+		release, err := strconv.Atoi(strings.TrimPrefix(suffix, "lke"))
+		if err != nil || !strings.HasPrefix(suffix, "lke") {
+			return v, invalid
+		}
+
+		v.release = release
+	}
+
+	return v, nil
+}
@@ -1,6 +1,10 @@
 package linodego
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"net"
+)
 
 // LKEClusterControlPlane fields contained within the `control_plane` attribute of an LKE cluster.
 type LKEClusterControlPlane struct {
@@ -29,6 +33,49 @@ type LKEClusterControlPlaneACLAddressesOptions struct {
 	IPv6 *[]string `json:"ipv6,omitempty"`
 }
 
+// Validate checks that each address is an IP address or CIDR range of the
+// family matching the list it appears in.
+func (o LKEClusterControlPlaneACLAddressesOptions) Validate() error {
+	if o.IPv4 != nil {
+		for _, address := range *o.IPv4 {
+			if err := validateACLAddress(address, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.IPv6 != nil {
+		for _, address := range *o.IPv6 {
+			if err := validateACLAddress(address, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateACLAddress(address string, ipv6 bool) error {
+	family := "IPv4"
+	if ipv6 {
+		family = "IPv6"
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		var err error
+		if ip, _, err = net.ParseCIDR(address); err != nil {
+			return fmt.Errorf("invalid %s address %q: must be an IP address or CIDR range", family, address)
+		}
+	}
+
+	if (ip.To4() == nil) != ipv6 {
+		return fmt.Errorf("invalid %s address %q: address is not %s", family, address, family)
+	}
+
+	return nil
+}
+
 // LKEClusterControlPlaneACLOptions represents the options used when
 // configuring an LKE cluster's control plane ACL policy.
 type LKEClusterControlPlaneACLOptions struct {
@@ -44,6 +91,28 @@ type LKEClusterControlPlaneOptions struct {
 	ACL              *LKEClusterControlPlaneACLOptions `json:"acl,omitempty"`
 }
 
+// Clone returns a deep copy of the LKEClusterControlPlaneOptions.
+func (o LKEClusterControlPlaneOptions) Clone() LKEClusterControlPlaneOptions {
+	o.HighAvailability = copyBool(o.HighAvailability)
+
+	if o.ACL != nil {
+		acl := *o.ACL
+		acl.Enabled = copyBool(acl.Enabled)
+
+		if acl.Addresses != nil {
+			addresses := LKEClusterControlPlaneACLAddressesOptions{
+				IPv4: copySlicePtr(acl.Addresses.IPv4),
+				IPv6: copySlicePtr(acl.Addresses.IPv6),
+			}
+			acl.Addresses = &addresses
+		}
+
+		o.ACL = &acl
+	}
+
+	return o
+}
+
 // LKEClusterControlPlaneACLUpdateOptions represents the options
 // available when updating the ACL configuration of an LKE cluster's
 // control plane.
@@ -68,12 +137,19 @@ func (c *Client) GetLKEClusterControlPlaneACL(ctx context.Context, clusterID int
 }
 
 // UpdateLKEClusterControlPlaneACL updates the ACL configuration for the
-// given cluster's control plane.
+// given cluster's control plane. The addresses in opts are validated before
+// the request is sent.
 func (c *Client) UpdateLKEClusterControlPlaneACL(
 	ctx context.Context,
 	clusterID int,
 	opts LKEClusterControlPlaneACLUpdateOptions,
 ) (*LKEClusterControlPlaneACLResponse, error) {
+	if opts.ACL.Addresses != nil {
+		if err := opts.ACL.Addresses.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	return doPUTRequest[LKEClusterControlPlaneACLResponse](
 		ctx,
 		c,
@@ -2,6 +2,8 @@ package linodego
 
 import (
 	"context"
+	"maps"
+	"slices"
 )
 
 // LKELinodeStatus constants start with LKELinode and include
@@ -36,6 +38,14 @@ type LKENodePoolAutoscaler struct {
 	Max     int  `json:"max"`
 }
 
+// LKENodePoolAutoscalerUpdateOptions fields are those accepted by UpdateLKENodePoolAutoscaler.
+// Min and Max may be left nil when disabling the autoscaler.
+type LKENodePoolAutoscalerUpdateOptions struct {
+	Enabled bool `json:"enabled"`
+	Min     *int `json:"min,omitempty"`
+	Max     *int `json:"max,omitempty"`
+}
+
 // LKENodePoolLinode represents a LKENodePoolLinode object
 type LKENodePoolLinode struct {
 	ID         string          `json:"id"`
@@ -101,6 +111,19 @@ type LKENodePoolCreateOptions struct {
 	UpdateStrategy *LKENodePoolUpdateStrategy `json:"update_strategy,omitempty"`
 }
 
+// Clone returns a deep copy of the LKENodePoolCreateOptions.
+func (o LKENodePoolCreateOptions) Clone() LKENodePoolCreateOptions {
+	o.Disks = slices.Clone(o.Disks)
+	o.Tags = slices.Clone(o.Tags)
+	o.Labels = maps.Clone(o.Labels)
+	o.Taints = slices.Clone(o.Taints)
+	o.Autoscaler = copyPtr(o.Autoscaler)
+	o.K8sVersion = copyString(o.K8sVersion)
+	o.UpdateStrategy = copyPtr(o.UpdateStrategy)
+
+	return o
+}
+
 // LKENodePoolUpdateOptions fields are those accepted by UpdateLKENodePoolUpdate
 type LKENodePoolUpdateOptions struct {
 	Count  int                 `json:"count,omitempty"`
@@ -142,6 +165,16 @@ func (l LKENodePool) GetUpdateOptions() (o LKENodePoolUpdateOptions) {
 	return
 }
 
+// NodeIDs returns the IDs of the nodes currently in the LKENodePool
+func (l LKENodePool) NodeIDs() []string {
+	ids := make([]string, len(l.Linodes))
+	for i, node := range l.Linodes {
+		ids[i] = node.ID
+	}
+
+	return ids
+}
+
 // ListLKENodePools lists LKENodePools
 func (c *Client) ListLKENodePools(ctx context.Context, clusterID int, opts *ListOptions) ([]LKENodePool, error) {
 	return getPaginatedResults[LKENodePool](ctx, c, formatAPIPath("lke/clusters/%d/pools", clusterID), opts)
@@ -159,10 +192,20 @@ func (c *Client) CreateLKENodePool(ctx context.Context, clusterID int, opts LKEN
 	return doPOSTRequest[LKENodePool](ctx, c, e, opts)
 }
 
-// RecycleLKENodePool recycles a LKENodePool
-func (c *Client) RecycleLKENodePool(ctx context.Context, clusterID, poolID int) error {
+// RecycleLKENodePool recycles a LKENodePool, returning the IDs of the nodes being replaced.
+// Nodes are replaced one at a time, so the IDs can be used to track the progress of the recycle.
+func (c *Client) RecycleLKENodePool(ctx context.Context, clusterID, poolID int) ([]string, error) {
+	pool, err := c.GetLKENodePool(ctx, clusterID, poolID)
+	if err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("lke/clusters/%d/pools/%d/recycle", clusterID, poolID)
-	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
+	if err := doPOSTRequestNoRequestResponseBody(ctx, c, e); err != nil {
+		return nil, err
+	}
+
+	return pool.NodeIDs(), nil
 }
 
 // UpdateLKENodePool updates the LKENodePool with the specified id
@@ -171,6 +214,16 @@ func (c *Client) UpdateLKENodePool(ctx context.Context, clusterID, poolID int, o
 	return doPUTRequest[LKENodePool](ctx, c, e, opts)
 }
 
+// UpdateLKENodePoolAutoscaler updates the autoscaler configuration of the LKENodePool with the specified id
+func (c *Client) UpdateLKENodePoolAutoscaler(
+	ctx context.Context, clusterID, poolID int, opts LKENodePoolAutoscalerUpdateOptions,
+) (*LKENodePool, error) {
+	e := formatAPIPath("lke/clusters/%d/pools/%d", clusterID, poolID)
+	return doPUTRequest[LKENodePool](ctx, c, e, struct {
+		Autoscaler LKENodePoolAutoscalerUpdateOptions `json:"autoscaler"`
+	}{opts})
+}
+
 // DeleteLKENodePool deletes the LKENodePool with the specified id
 func (c *Client) DeleteLKENodePool(ctx context.Context, clusterID, poolID int) error {
 	e := formatAPIPath("lke/clusters/%d/pools/%d", clusterID, poolID)
@@ -189,6 +242,21 @@ func (c *Client) RecycleLKENodePoolNode(ctx context.Context, clusterID int, node
 	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
 }
 
+// ListLKEClusterNodeIDs lists the IDs of the nodes in every pool of the specified LKE Cluster.
+func (c *Client) ListLKEClusterNodeIDs(ctx context.Context, clusterID int) ([]string, error) {
+	pools, err := c.ListLKENodePools(ctx, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, pool := range pools {
+		ids = append(ids, pool.NodeIDs()...)
+	}
+
+	return ids, nil
+}
+
 // DeleteLKENodePoolNode deletes a given node from a node pool
 func (c *Client) DeleteLKENodePoolNode(ctx context.Context, clusterID int, nodeID string) error {
 	e := formatAPIPath("lke/clusters/%d/nodes/%s", clusterID, nodeID)
@@ -32,7 +32,7 @@ func (c *Client) ListLKETypes(ctx context.Context, opts *ListOptions) ([]LKEType
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
 		return result.([]LKEType), nil
 	}
 
@@ -41,7 +41,7 @@ func (c *Client) ListLKETypes(ctx context.Context, opts *ListOptions) ([]LKEType
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
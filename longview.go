@@ -77,12 +77,15 @@ func (c *Client) UpdateLongviewClient(ctx context.Context, clientID int, opts Lo
 	return doPUTRequest[LongviewClient](ctx, c, e, opts)
 }
 
-// GetLongviewPlan gets the template with the provided ID
+// GetLongviewPlan gets the Longview plan the account is currently subscribed to
 func (c *Client) GetLongviewPlan(ctx context.Context) (*LongviewPlan, error) {
 	return doGETRequest[LongviewPlan](ctx, c, "longview/plan")
 }
 
-// UpdateLongviewPlan updates a Longview Plan
+// UpdateLongviewPlan updates the account's Longview subscription and returns the
+// resulting plan, including its pricing. Upgrading from the free tier to a paid plan
+// requires billing to be set up on the account; otherwise the API responds with a 403,
+// which can be checked with ErrHasStatus(err, http.StatusForbidden).
 func (c *Client) UpdateLongviewPlan(ctx context.Context, opts LongviewPlanUpdateOptions) (*LongviewPlan, error) {
 	return doPUTRequest[LongviewPlan](ctx, c, "longview/plan", opts)
 }
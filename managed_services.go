@@ -0,0 +1,160 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// ManagedServiceType constants start with ManagedServiceType and include Linode API Managed Service types
+type ManagedServiceType string
+
+// ManagedServiceType constants reflect the kind of check performed by a Managed Service monitor
+const (
+	ManagedServiceTypeURL ManagedServiceType = "url"
+	ManagedServiceTypeTCP ManagedServiceType = "tcp"
+)
+
+// ManagedServiceStatus constants start with ManagedServiceStatus and include Linode API Managed Service statuses
+type ManagedServiceStatus string
+
+// ManagedServiceStatus constants reflect the current state of a Managed Service monitor
+const (
+	ManagedServiceStatusDisabled ManagedServiceStatus = "disabled"
+	ManagedServiceStatusPending  ManagedServiceStatus = "pending"
+	ManagedServiceStatusOK       ManagedServiceStatus = "ok"
+	ManagedServiceStatusProblem  ManagedServiceStatus = "problem"
+)
+
+// ManagedService represents a service monitored by Linode Managed
+type ManagedService struct {
+	ID          int                  `json:"id"`
+	Label       string               `json:"label"`
+	Status      ManagedServiceStatus `json:"status"`
+	ServiceType ManagedServiceType   `json:"service_type"`
+	Address     string               `json:"address"`
+	Timeout     int                  `json:"timeout"`
+	Body        string               `json:"body"`
+	Notes       string               `json:"notes"`
+	Region      *string              `json:"region"`
+
+	// ConsultationGroup is the group of Managed Contacts that should be notified
+	// when this service reports a problem. The API has no per-service list of
+	// notification contacts; contacts are notified through their group.
+	ConsultationGroup string `json:"consultation_group"`
+
+	// Credentials are the IDs of the Managed Credentials used to access this service.
+	Credentials []int `json:"credentials"`
+
+	Created *time.Time `json:"-"`
+	Updated *time.Time `json:"-"`
+}
+
+// ManagedServiceCreateOptions fields are those accepted by CreateManagedService
+type ManagedServiceCreateOptions struct {
+	Label             string             `json:"label"`
+	ServiceType       ManagedServiceType `json:"service_type"`
+	Address           string             `json:"address"`
+	Timeout           int                `json:"timeout"`
+	Body              string             `json:"body,omitempty"`
+	Notes             string             `json:"notes,omitempty"`
+	Region            *string            `json:"region,omitempty"`
+	ConsultationGroup string             `json:"consultation_group,omitempty"`
+	Credentials       []int              `json:"credentials,omitempty"`
+}
+
+// ManagedServiceUpdateOptions fields are those accepted by UpdateManagedService
+type ManagedServiceUpdateOptions struct {
+	Label             string             `json:"label,omitempty"`
+	ServiceType       ManagedServiceType `json:"service_type,omitempty"`
+	Address           string             `json:"address,omitempty"`
+	Timeout           int                `json:"timeout,omitempty"`
+	Body              *string            `json:"body,omitempty"`
+	Notes             *string            `json:"notes,omitempty"`
+	Region            *string            `json:"region,omitempty"`
+	ConsultationGroup *string            `json:"consultation_group,omitempty"`
+	Credentials       *[]int             `json:"credentials,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *ManagedService) UnmarshalJSON(b []byte) error {
+	type Mask ManagedService
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// GetCreateOptions converts a ManagedService to ManagedServiceCreateOptions for use in CreateManagedService
+func (i ManagedService) GetCreateOptions() ManagedServiceCreateOptions {
+	return ManagedServiceCreateOptions{
+		Label:             i.Label,
+		ServiceType:       i.ServiceType,
+		Address:           i.Address,
+		Timeout:           i.Timeout,
+		Body:              i.Body,
+		Notes:             i.Notes,
+		Region:            copyString(i.Region),
+		ConsultationGroup: i.ConsultationGroup,
+		Credentials:       slices.Clone(i.Credentials),
+	}
+}
+
+// GetUpdateOptions converts a ManagedService to ManagedServiceUpdateOptions for use in UpdateManagedService
+func (i ManagedService) GetUpdateOptions() ManagedServiceUpdateOptions {
+	return ManagedServiceUpdateOptions{
+		Label:             i.Label,
+		ServiceType:       i.ServiceType,
+		Address:           i.Address,
+		Timeout:           i.Timeout,
+		Body:              copyString(&i.Body),
+		Notes:             copyString(&i.Notes),
+		Region:            copyString(i.Region),
+		ConsultationGroup: copyString(&i.ConsultationGroup),
+		Credentials:       copySlicePtr(&i.Credentials),
+	}
+}
+
+// ListManagedServices lists the services monitored by Linode Managed
+func (c *Client) ListManagedServices(ctx context.Context, opts *ListOptions) ([]ManagedService, error) {
+	return getPaginatedResults[ManagedService](ctx, c, "managed/services", opts)
+}
+
+// GetManagedService gets the Managed Service with the provided ID
+func (c *Client) GetManagedService(ctx context.Context, serviceID int) (*ManagedService, error) {
+	e := formatAPIPath("managed/services/%d", serviceID)
+	return doGETRequest[ManagedService](ctx, c, e)
+}
+
+// CreateManagedService creates a Managed Service monitor
+func (c *Client) CreateManagedService(ctx context.Context, opts ManagedServiceCreateOptions) (*ManagedService, error) {
+	return doPOSTRequest[ManagedService](ctx, c, "managed/services", opts)
+}
+
+// UpdateManagedService updates the Managed Service with the specified ID
+func (c *Client) UpdateManagedService(ctx context.Context, serviceID int, opts ManagedServiceUpdateOptions) (*ManagedService, error) {
+	e := formatAPIPath("managed/services/%d", serviceID)
+	return doPUTRequest[ManagedService](ctx, c, e, opts)
+}
+
+// DeleteManagedService deletes the Managed Service with the specified ID
+func (c *Client) DeleteManagedService(ctx context.Context, serviceID int) error {
+	e := formatAPIPath("managed/services/%d", serviceID)
+	return doDELETERequest(ctx, c, e)
+}
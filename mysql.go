@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -173,6 +174,17 @@ func (c *Client) CreateMySQLDatabase(ctx context.Context, opts MySQLCreateOption
 	return doPOSTRequest[MySQLDatabase](ctx, c, "databases/mysql/instances", opts)
 }
 
+// CreateMySQLDatabaseFork creates a new MySQL Database forked from opts.Fork.Source as it was at
+// opts.Fork.RestoreTime, or at the latest available point if RestoreTime is nil. The returned
+// Database will be provisioning; use WaitForDatabaseStatus to wait for it to become active.
+func (c *Client) CreateMySQLDatabaseFork(ctx context.Context, opts MySQLCreateOptions) (*MySQLDatabase, error) {
+	if opts.Fork == nil || opts.Fork.Source == 0 {
+		return nil, errors.New("a fork source database must be provided")
+	}
+
+	return doPOSTRequest[MySQLDatabase](ctx, c, "databases/mysql/instances", opts)
+}
+
 // DeleteMySQLDatabase deletes an existing MySQL Database with the given id
 func (c *Client) DeleteMySQLDatabase(ctx context.Context, databaseID int) error {
 	e := formatAPIPath("databases/mysql/instances/%d", databaseID)
@@ -181,10 +193,117 @@ func (c *Client) DeleteMySQLDatabase(ctx context.Context, databaseID int) error
 
 // UpdateMySQLDatabase updates the given MySQL Database with the provided opts, returns the MySQLDatabase with the new settings
 func (c *Client) UpdateMySQLDatabase(ctx context.Context, databaseID int, opts MySQLUpdateOptions) (*MySQLDatabase, error) {
+	if opts.AllowList != nil {
+		if err := validateDatabaseAllowList(*opts.AllowList); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Updates != nil {
+		if err := opts.Updates.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	e := formatAPIPath("databases/mysql/instances/%d", databaseID)
 	return doPUTRequest[MySQLDatabase](ctx, c, e, opts)
 }
 
+// GetMySQLDatabaseAllowList returns the IP addresses and CIDR ranges allowed to connect
+// to the given MySQL Database.
+func (c *Client) GetMySQLDatabaseAllowList(ctx context.Context, databaseID int) ([]string, error) {
+	db, err := c.GetMySQLDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.AllowList, nil
+}
+
+// UpdateMySQLDatabaseAllowList replaces the full allow list of the given MySQL Database.
+// Any entry not in allowList loses access, and an empty allowList blocks all connections.
+// Use AddMySQLDatabaseAllowedIP or RemoveMySQLDatabaseAllowedIP to change a single entry.
+func (c *Client) UpdateMySQLDatabaseAllowList(ctx context.Context, databaseID int, allowList []string) ([]string, error) {
+	if allowList == nil {
+		allowList = []string{}
+	}
+
+	db, err := c.UpdateMySQLDatabase(ctx, databaseID, MySQLUpdateOptions{AllowList: &allowList})
+	if err != nil {
+		return nil, err
+	}
+
+	return db.AllowList, nil
+}
+
+// AddMySQLDatabaseAllowedIP adds an IP address or CIDR range to the allow list of the given
+// MySQL Database and returns the resulting allow list. The allow list is read and then
+// replaced, so concurrent changes to it may be lost. No update is made if the entry is
+// already present.
+func (c *Client) AddMySQLDatabaseAllowedIP(ctx context.Context, databaseID int, entry string) ([]string, error) {
+	if err := validateDatabaseAllowList([]string{entry}); err != nil {
+		return nil, err
+	}
+
+	allowList, err := c.GetMySQLDatabaseAllowList(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowList, changed := addToDatabaseAllowList(allowList, entry)
+	if !changed {
+		return allowList, nil
+	}
+
+	return c.UpdateMySQLDatabaseAllowList(ctx, databaseID, allowList)
+}
+
+// RemoveMySQLDatabaseAllowedIP removes an IP address or CIDR range from the allow list of the
+// given MySQL Database and returns the resulting allow list. The allow list is read and then
+// replaced, so concurrent changes to it may be lost. No update is made if the entry is
+// not present.
+func (c *Client) RemoveMySQLDatabaseAllowedIP(ctx context.Context, databaseID int, entry string) ([]string, error) {
+	allowList, err := c.GetMySQLDatabaseAllowList(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowList, changed := removeFromDatabaseAllowList(allowList, entry)
+	if !changed {
+		return allowList, nil
+	}
+
+	return c.UpdateMySQLDatabaseAllowList(ctx, databaseID, allowList)
+}
+
+// GetMySQLDatabaseMaintenanceWindow returns the maintenance window of the given MySQL Database,
+// including any pending updates.
+func (c *Client) GetMySQLDatabaseMaintenanceWindow(ctx context.Context, databaseID int) (*DatabaseMaintenanceWindow, error) {
+	db, err := c.GetMySQLDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &db.Updates, nil
+}
+
+// UpdateMySQLDatabaseMaintenanceWindow sets the maintenance window of the given MySQL Database.
+// Use PatchMySQLDatabase to apply pending updates immediately.
+func (c *Client) UpdateMySQLDatabaseMaintenanceWindow(
+	ctx context.Context,
+	databaseID int,
+	window DatabaseMaintenanceWindow,
+) (*DatabaseMaintenanceWindow, error) {
+	window.Pending = nil
+
+	db, err := c.UpdateMySQLDatabase(ctx, databaseID, MySQLUpdateOptions{Updates: &window})
+	if err != nil {
+		return nil, err
+	}
+
+	return &db.Updates, nil
+}
+
 // GetMySQLDatabaseSSL returns the SSL Certificate for the given MySQL Database
 func (c *Client) GetMySQLDatabaseSSL(ctx context.Context, databaseID int) (*MySQLDatabaseSSL, error) {
 	e := formatAPIPath("databases/mysql/instances/%d/ssl", databaseID)
@@ -197,7 +316,11 @@ func (c *Client) GetMySQLDatabaseCredentials(ctx context.Context, databaseID int
 	return doGETRequest[MySQLDatabaseCredential](ctx, c, e)
 }
 
-// ResetMySQLDatabaseCredentials returns the Root Credentials for the given MySQL Database (may take a few seconds to work)
+// ResetMySQLDatabaseCredentials rotates the Root Credentials for the given MySQL Database.
+// The rotation is asynchronous: a nil error means the request was accepted and the new
+// credentials will be returned by GetMySQLDatabaseCredentials after a few seconds, while a
+// non-nil error means the rotation was not started. Existing connections using the old
+// credentials will be dropped.
 func (c *Client) ResetMySQLDatabaseCredentials(ctx context.Context, databaseID int) error {
 	e := formatAPIPath("databases/mysql/instances/%d/credentials/reset", databaseID)
 	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
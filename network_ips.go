@@ -2,6 +2,10 @@ package linodego
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 )
 
 // IPAddressUpdateOptionsV2 fields are those accepted by UpdateIPAddress.
@@ -89,6 +93,36 @@ func (c *Client) UpdateIPAddressV2(ctx context.Context, address string, opts IPA
 	return doPUTRequest[InstanceIP](ctx, c, e, opts)
 }
 
+// UpdateIPAddressRDNS sets the reverse DNS of the given IP address. Because the API rejects RDNS
+// values whose forward DNS does not yet resolve to the address, requests failing for that reason
+// are retried every opts.PollInterval until they succeed, opts.Timeout elapses or ctx is done.
+// Any other error is returned immediately.
+func (c *Client) UpdateIPAddressRDNS(ctx context.Context, address, rdns string, opts WaitOptions) (*InstanceIP, error) {
+	ctx, cancel := opts.withTimeout(ctx)
+	defer cancel()
+
+	updateOpts := IPAddressUpdateOptionsV2{
+		RDNS: Pointer(&rdns),
+	}
+
+	for {
+		ip, err := c.UpdateIPAddressV2(ctx, address, updateOpts)
+		if err == nil {
+			return ip, nil
+		}
+
+		if !ErrHasStatus(err, http.StatusBadRequest) || !ErrHasReason(err, "does not resolve") {
+			return nil, err
+		}
+
+		select {
+		case <-time.After(opts.pollInterval()):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to update RDNS for %s before forward DNS resolved: %w", address, err)
+		}
+	}
+}
+
 // UpdateIPAddress updates the IP address with the specified id.
 // Deprecated: Please use UpdateIPAddressV2 for all new implementation.
 func (c *Client) UpdateIPAddress(ctx context.Context, id string, opts IPAddressUpdateOptions) (*InstanceIP, error) {
@@ -108,6 +142,41 @@ func (c *Client) ShareIPAddresses(ctx context.Context, opts IPAddressesShareOpti
 	return doPOSTRequestNoResponseBody(ctx, c, "networking/ips/share", opts)
 }
 
+// ShareIPAddressesChecked verifies that every IP address in opts is in the same region as the
+// target Linode before sharing them, then returns the Linode's resulting IP configuration.
+// As with ShareIPAddresses, opts.IPs replaces the Linode's full set of shared addresses.
+// IPv6 ranges (addresses containing a prefix length) are not checked client-side.
+func (c *Client) ShareIPAddressesChecked(ctx context.Context, opts IPAddressesShareOptions) (*InstanceIPAddressResponse, error) {
+	instance, err := c.GetInstance(ctx, opts.LinodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range opts.IPs {
+		if strings.Contains(address, "/") {
+			continue
+		}
+
+		ip, err := c.GetIPAddress(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+
+		if ip.Region != instance.Region {
+			return nil, fmt.Errorf(
+				"IP address %s is in region %s, but Linode %d is in region %s",
+				address, ip.Region, opts.LinodeID, instance.Region,
+			)
+		}
+	}
+
+	if err := c.ShareIPAddresses(ctx, opts); err != nil {
+		return nil, err
+	}
+
+	return c.GetInstanceIPAddresses(ctx, opts.LinodeID)
+}
+
 // AllocateReserveIP allocates a new IPv4 address to the Account, with the option to reserve it
 // and optionally assign it to a Linode.
 func (c *Client) AllocateReserveIP(ctx context.Context, opts AllocateReserveIPOptions) (*InstanceIP, error) {
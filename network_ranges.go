@@ -2,6 +2,8 @@ package linodego
 
 import (
 	"context"
+	"errors"
+	"fmt"
 )
 
 // IPv6RangeCreateOptions fields are those accepted by CreateIPv6Range
@@ -23,7 +25,16 @@ func (c *Client) GetIPv6Range(ctx context.Context, ipRange string) (*IPv6Range,
 }
 
 // CreateIPv6Range creates an IPv6 Range and assigns it based on the provided Linode or route target IPv6 SLAAC address.
+// Either LinodeID or RouteTarget must be provided, and PrefixLength must be 56 or 64.
 func (c *Client) CreateIPv6Range(ctx context.Context, opts IPv6RangeCreateOptions) (*IPv6Range, error) {
+	if opts.LinodeID == 0 && opts.RouteTarget == "" {
+		return nil, errors.New("either a Linode ID or a route target must be provided")
+	}
+
+	if opts.PrefixLength != 56 && opts.PrefixLength != 64 {
+		return nil, fmt.Errorf("invalid prefix length %d: must be 56 or 64", opts.PrefixLength)
+	}
+
 	return doPOSTRequest[IPv6Range](ctx, c, "networking/ipv6/ranges", opts)
 }
 
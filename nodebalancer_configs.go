@@ -238,7 +238,11 @@ func (c *Client) DeleteNodeBalancerConfig(ctx context.Context, nodebalancerID in
 	return doDELETERequest(ctx, c, e)
 }
 
-// RebuildNodeBalancerConfig updates the NodeBalancer with the specified id
+// RebuildNodeBalancerConfig rebuilds the NodeBalancerConfig with the specified id, replacing all
+// of its nodes with those in opts in a single request. Nodes with an ID are updated, nodes without
+// one are created, and any existing nodes not included are removed.
+// The returned NodeBalancerConfig only reports node status counts; use ListNodeBalancerNodes
+// to retrieve the resulting nodes.
 func (c *Client) RebuildNodeBalancerConfig(ctx context.Context, nodeBalancerID int, configID int, opts NodeBalancerConfigRebuildOptions) (*NodeBalancerConfig, error) {
 	e := formatAPIPath("nodebalancers/%d/configs/%d/rebuild", nodeBalancerID, configID)
 	return doPOSTRequest[NodeBalancerConfig](ctx, c, e, opts)
@@ -4,7 +4,9 @@ import (
 	"context"
 )
 
-// ListNodeBalancerFirewalls returns a paginated list of Cloud Firewalls for nodebalancerID
+// ListNodeBalancerFirewalls returns a paginated list of Cloud Firewalls for nodebalancerID.
+// Use AttachFirewallToNodeBalancer and DetachFirewallFromNodeBalancer to change which
+// Firewalls protect the NodeBalancer without managing FirewallDevices directly.
 func (c *Client) ListNodeBalancerFirewalls(ctx context.Context, nodebalancerID int, opts *ListOptions) ([]Firewall, error) {
 	return getPaginatedResults[Firewall](ctx, c, formatAPIPath("nodebalancers/%d/firewalls", nodebalancerID), opts)
 }
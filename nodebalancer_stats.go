@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"time"
 )
 
 // NodeBalancerStats represents a nodebalancer stats object
@@ -22,7 +23,48 @@ type StatsTraffic struct {
 	Out [][]float64 `json:"out"`
 }
 
-// GetNodeBalancerStats gets the template with the provided ID
+// StatsPoint represents a single sample of a stats time series
+type StatsPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// ParseStatsPoints converts a raw stats series of [timestamp, value] pairs,
+// where timestamps are in milliseconds since the Unix epoch, into StatsPoints.
+// Malformed pairs are skipped.
+func ParseStatsPoints(series [][]float64) []StatsPoint {
+	points := make([]StatsPoint, 0, len(series))
+
+	for _, pair := range series {
+		if len(pair) != 2 {
+			continue
+		}
+
+		points = append(points, StatsPoint{
+			Time:  time.UnixMilli(int64(pair[0])).UTC(),
+			Value: pair[1],
+		})
+	}
+
+	return points
+}
+
+// ConnectionPoints returns the connections series as typed StatsPoints
+func (d NodeBalancerStatsData) ConnectionPoints() []StatsPoint {
+	return ParseStatsPoints(d.Connections)
+}
+
+// InPoints returns the inbound traffic series as typed StatsPoints
+func (t StatsTraffic) InPoints() []StatsPoint {
+	return ParseStatsPoints(t.In)
+}
+
+// OutPoints returns the outbound traffic series as typed StatsPoints
+func (t StatsTraffic) OutPoints() []StatsPoint {
+	return ParseStatsPoints(t.Out)
+}
+
+// GetNodeBalancerStats gets the connection and traffic stats for the NodeBalancer with the provided ID
 func (c *Client) GetNodeBalancerStats(ctx context.Context, nodebalancerID int) (*NodeBalancerStats, error) {
 	e := formatAPIPath("nodebalancers/%d/stats", nodebalancerID)
 	return doGETRequest[NodeBalancerStats](ctx, c, e)
@@ -30,7 +30,7 @@ func (c *Client) ListNodeBalancerTypes(ctx context.Context, opts *ListOptions) (
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
 		return result.([]NodeBalancerType), nil
 	}
 
@@ -39,7 +39,7 @@ func (c *Client) ListNodeBalancerTypes(ctx context.Context, opts *ListOptions) (
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -0,0 +1,72 @@
+package linodego
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Null holds an optional value that needs to tell "leave unchanged" apart from
+// "set to the zero value" and "clear". A nil *Null[T] is left unchanged, NullOf sends
+// its value even if it is the zero value of T, and ExplicitNull sends a JSON null.
+//
+// Update options use *Null[T] for fields that can be cleared, for example:
+//
+//	// Sends {"label":""}, leaving all other fields unchanged
+//	opts := linodego.InstanceUpdateOptions{Label: linodego.NullOf("")}
+//
+//	// Sends {"tax_id":null}
+//	accountOpts := linodego.AccountUpdateOptions{TaxID: linodego.ExplicitNull[string]()}
+//
+// NOTE: When decoding JSON, a null value leaves a *Null[T] field nil rather than
+// calling UnmarshalJSON, so use a Null[T] value field to observe explicit nulls.
+type Null[T any] struct {
+	value T
+	valid bool
+}
+
+// NullOf returns a Null holding value, which is sent even if it is the zero value of T.
+func NullOf[T any](value T) *Null[T] {
+	return &Null[T]{value: value, valid: true}
+}
+
+// ExplicitNull returns a Null that is sent as a JSON null.
+func ExplicitNull[T any]() *Null[T] {
+	return &Null[T]{}
+}
+
+// Get returns the held value and whether it is set. The zero value of T and false
+// are returned for an explicit null.
+func (n Null[T]) Get() (T, bool) {
+	return n.value, n.valid
+}
+
+// IsNull returns whether n represents an explicit null.
+func (n Null[T]) IsNull() bool {
+	return !n.valid
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (n *Null[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+
+	*n = Null[T]{value: value, valid: true}
+
+	return nil
+}
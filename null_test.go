@@ -0,0 +1,66 @@
+package linodego
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNull_MarshalJSON(t *testing.T) {
+	type updateOptions struct {
+		Label       *Null[string] `json:"label,omitempty"`
+		Description *Null[string] `json:"description,omitempty"`
+		Count       *Null[int]    `json:"count,omitempty"`
+	}
+
+	tests := []struct {
+		name     string
+		opts     updateOptions
+		expected string
+	}{
+		{"unset", updateOptions{}, `{}`},
+		{"zero values", updateOptions{Label: NullOf(""), Count: NullOf(0)}, `{"label":"","count":0}`},
+		{"explicit null", updateOptions{Description: ExplicitNull[string]()}, `{"description":null}`},
+		{"values", updateOptions{Label: NullOf("web"), Count: NullOf(3)}, `{"label":"web","count":3}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, b)
+			}
+		})
+	}
+}
+
+func TestNull_UnmarshalJSON(t *testing.T) {
+	var result struct {
+		Label       Null[string] `json:"label"`
+		Description Null[string] `json:"description"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"label":"","description":null}`), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if value, ok := result.Label.Get(); !ok || value != "" {
+		t.Errorf("expected label to be set to an empty string, got %q (set: %t)", value, ok)
+	}
+
+	if !result.Description.IsNull() {
+		t.Error("expected description to be an explicit null")
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"label":"","description":null}` {
+		t.Errorf("expected JSON to round-trip, got %s", b)
+	}
+}
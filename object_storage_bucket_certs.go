@@ -2,6 +2,8 @@ package linodego
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
 )
 
 // Deprecated: Please use ObjectStorageBucketCertV2 for all new implementations.
@@ -18,9 +20,23 @@ type ObjectStorageBucketCertUploadOptions struct {
 	PrivateKey  string `json:"private_key"`
 }
 
+// Validate checks that the Certificate and PrivateKey are PEM encoded
+// and that the private key matches the certificate's public key.
+func (o ObjectStorageBucketCertUploadOptions) Validate() error {
+	if _, err := tls.X509KeyPair([]byte(o.Certificate), []byte(o.PrivateKey)); err != nil {
+		return fmt.Errorf("invalid certificate and private key pair: %w", err)
+	}
+
+	return nil
+}
+
 // UploadObjectStorageBucketCert uploads a TLS/SSL Cert to be used with an Object Storage Bucket.
 // Deprecated: Please use UploadObjectStorageBucketCertV2 for all new implementations.
 func (c *Client) UploadObjectStorageBucketCert(ctx context.Context, clusterOrRegionID, bucket string, opts ObjectStorageBucketCertUploadOptions) (*ObjectStorageBucketCert, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("object-storage/buckets/%s/%s/ssl", clusterOrRegionID, bucket)
 	return doPOSTRequest[ObjectStorageBucketCert](ctx, c, e, opts)
 }
@@ -32,8 +48,13 @@ func (c *Client) GetObjectStorageBucketCert(ctx context.Context, clusterOrRegion
 	return doGETRequest[ObjectStorageBucketCert](ctx, c, e)
 }
 
-// UploadObjectStorageBucketCert uploads a TLS/SSL Cert to be used with an Object Storage Bucket.
+// UploadObjectStorageBucketCertV2 uploads a TLS/SSL Cert to be used with an Object Storage Bucket.
+// The certificate and private key are validated with ObjectStorageBucketCertUploadOptions.Validate before uploading.
 func (c *Client) UploadObjectStorageBucketCertV2(ctx context.Context, clusterOrRegionID, bucket string, opts ObjectStorageBucketCertUploadOptions) (*ObjectStorageBucketCertV2, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("object-storage/buckets/%s/%s/ssl", clusterOrRegionID, bucket)
 	return doPOSTRequest[ObjectStorageBucketCertV2](ctx, c, e, opts)
 }
@@ -107,10 +107,13 @@ type ObjectStorageBucketUpdateAccessOptions struct {
 
 // ObjectStorageBucketListContentsParams fields are the query parameters for ListObjectStorageBucketContents
 type ObjectStorageBucketListContentsParams struct {
-	Marker    *string
-	Delimiter *string
-	Prefix    *string
-	PageSize  *int
+	// Marker is the NextMarker of a previous truncated listing to continue from.
+	Marker *string `url:"marker,omitempty"`
+
+	// Delimiter groups object names sharing a prefix up to the delimiter, e.g. "/".
+	Delimiter *string `url:"delimiter,omitempty"`
+	Prefix    *string `url:"prefix,omitempty"`
+	PageSize  *int    `url:"page_size,omitempty"`
 }
 
 // ObjectStorageACL options start with ACL and include all known ACL types
@@ -152,13 +155,15 @@ func (c *Client) GetObjectStorageBucketAccess(ctx context.Context, clusterOrRegi
 	return doGETRequest[ObjectStorageBucketAccess](ctx, c, e)
 }
 
-// UpdateObjectStorageBucketAccess updates the access configuration for an ObjectStorageBucket
+// UpdateObjectStorageBucketAccess updates the access configuration for an ObjectStorageBucket.
+// The API does not return the resulting configuration; use GetObjectStorageBucketAccessV2
+// to read back the effective settings.
 func (c *Client) UpdateObjectStorageBucketAccess(ctx context.Context, clusterOrRegionID, label string, opts ObjectStorageBucketUpdateAccessOptions) error {
 	e := formatAPIPath("object-storage/buckets/%s/%s/access", clusterOrRegionID, label)
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
-// GetObjectStorageBucketAccess gets the current access config for a bucket
+// GetObjectStorageBucketAccessV2 gets the current access config for a bucket
 func (c *Client) GetObjectStorageBucketAccessV2(ctx context.Context, clusterOrRegionID, label string) (*ObjectStorageBucketAccessV2, error) {
 	e := formatAPIPath("object-storage/buckets/%s/%s/access", clusterOrRegionID, label)
 	return doGETRequest[ObjectStorageBucketAccessV2](ctx, c, e)
@@ -170,7 +175,8 @@ func (c *Client) DeleteObjectStorageBucket(ctx context.Context, clusterOrRegionI
 	return doDELETERequest(ctx, c, e)
 }
 
-// Lists the contents of the specified ObjectStorageBucket
+// ListObjectStorageBucketContents lists a page of the contents of the specified ObjectStorageBucket.
+// If the returned content IsTruncated, pass its NextMarker as params.Marker to fetch the next page.
 func (c *Client) ListObjectStorageBucketContents(ctx context.Context, clusterOrRegionID, label string, params *ObjectStorageBucketListContentsParams) (*ObjectStorageBucketContent, error) {
 	basePath := formatAPIPath("object-storage/buckets/%s/%s/object-list", clusterOrRegionID, label)
 
@@ -180,7 +186,9 @@ func (c *Client) ListObjectStorageBucketContents(ctx context.Context, clusterOrR
 		if err != nil {
 			return nil, fmt.Errorf("failed to encode query params: %w", err)
 		}
-		queryString = "?" + values.Encode()
+		if len(values) > 0 {
+			queryString = "?" + values.Encode()
+		}
 	}
 
 	e := basePath + queryString
@@ -2,10 +2,12 @@ package linodego
 
 import "context"
 
-// NotificationType constants start with Notification and include all known Linode API Notification Types.
+// ObjectStorageEndpointType constants start with ObjectStorageEndpoint and include all known Linode API
+// Object Storage endpoint types.
 type ObjectStorageEndpointType string
 
-// NotificationType constants represent the actions that cause a Notification. New types may be added in the future.
+// ObjectStorageEndpointType constants represent the types of endpoints available in a region.
+// New types may be added in the future.
 const (
 	ObjectStorageEndpointE0 ObjectStorageEndpointType = "E0"
 	ObjectStorageEndpointE1 ObjectStorageEndpointType = "E1"
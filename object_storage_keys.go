@@ -2,6 +2,18 @@ package linodego
 
 import (
 	"context"
+	"errors"
+	"fmt"
+)
+
+// ObjectStoragePermission constants start with ObjectStoragePermission and include
+// the permission levels a limited ObjectStorageKey may be granted on a bucket
+type ObjectStoragePermission string
+
+// ObjectStoragePermission constants represent the access a limited key has to a bucket
+const (
+	ObjectStoragePermissionReadOnly  ObjectStoragePermission = "read_only"
+	ObjectStoragePermissionReadWrite ObjectStoragePermission = "read_write"
 )
 
 type ObjectStorageKeyRegion struct {
@@ -46,6 +58,85 @@ type ObjectStorageKeyUpdateOptions struct {
 	Regions []string `json:"regions,omitempty"`
 }
 
+// ObjectStorageKeyAccess builds the bucket access list of a limited ObjectStorageKey
+// for use with CreateScopedObjectStorageKey, e.g.
+//
+//	access := (&linodego.ObjectStorageKeyAccess{}).
+//		Grant("us-mia", "logs", linodego.ObjectStoragePermissionReadWrite).
+//		Grant("us-mia", "assets", linodego.ObjectStoragePermissionReadOnly)
+type ObjectStorageKeyAccess struct {
+	grants []ObjectStorageKeyBucketAccess
+}
+
+// Grant gives the key the given permission on a bucket in a region, replacing
+// any permission previously granted on the same bucket.
+func (a *ObjectStorageKeyAccess) Grant(region, bucket string, perm ObjectStoragePermission) *ObjectStorageKeyAccess {
+	for i, g := range a.grants {
+		if g.Region == region && g.BucketName == bucket {
+			a.grants[i].Permissions = string(perm)
+			return a
+		}
+	}
+
+	a.grants = append(a.grants, ObjectStorageKeyBucketAccess{
+		Region:      region,
+		BucketName:  bucket,
+		Permissions: string(perm),
+	})
+
+	return a
+}
+
+// BucketAccess validates the granted permissions and returns them
+// in the form accepted by ObjectStorageKeyCreateOptions.
+func (a *ObjectStorageKeyAccess) BucketAccess() ([]ObjectStorageKeyBucketAccess, error) {
+	if a == nil || len(a.grants) == 0 {
+		return nil, errors.New("at least one bucket must be granted to create a limited key")
+	}
+
+	var errs []error
+
+	for _, g := range a.grants {
+		if g.Region == "" || g.BucketName == "" {
+			errs = append(errs, fmt.Errorf("region and bucket must both be specified, got region %q and bucket %q", g.Region, g.BucketName))
+			continue
+		}
+
+		switch ObjectStoragePermission(g.Permissions) {
+		case ObjectStoragePermissionReadOnly, ObjectStoragePermissionReadWrite:
+		default:
+			errs = append(errs, fmt.Errorf(
+				"invalid permission %q for bucket %s/%s: must be %s or %s",
+				g.Permissions, g.Region, g.BucketName,
+				ObjectStoragePermissionReadOnly, ObjectStoragePermissionReadWrite,
+			))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return append([]ObjectStorageKeyBucketAccess(nil), a.grants...), nil
+}
+
+// CreateScopedObjectStorageKey creates a limited ObjectStorageKey that can only
+// access the buckets granted in access. An error is returned without creating a key
+// if access is empty, since that would otherwise create an unrestricted key.
+func (c *Client) CreateScopedObjectStorageKey(
+	ctx context.Context, label string, access *ObjectStorageKeyAccess,
+) (*ObjectStorageKey, error) {
+	bucketAccess, err := access.BucketAccess()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateObjectStorageKey(ctx, ObjectStorageKeyCreateOptions{
+		Label:        label,
+		BucketAccess: &bucketAccess,
+	})
+}
+
 // ListObjectStorageKeys lists ObjectStorageKeys
 func (c *Client) ListObjectStorageKeys(ctx context.Context, opts *ListOptions) ([]ObjectStorageKey, error) {
 	return getPaginatedResults[ObjectStorageKey](ctx, c, "object-storage/keys", opts)
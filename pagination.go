@@ -5,6 +5,7 @@ package linodego
  */
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -56,6 +57,170 @@ func (l ListOptions) Hash() (string, error) {
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
+// StreamAll requests the first page of the given paginated endpoint, then
+// requests the remaining pages concurrently, up to the client's stream concurrency at a time,
+// and streams the results over the returned channel in page order.
+// Only that many pages are held in memory at once; see Client.SetStreamConcurrency.
+//
+// If opts specifies a page, only that page is streamed.
+//
+// Both channels are closed once all pages have been consumed, the first
+// error is encountered, or ctx is cancelled. At most one error is sent on
+// the error channel, and cancellation is reported as ctx.Err().
+func StreamAll[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+) (<-chan T, <-chan error) {
+	results := make(chan T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(results)
+		defer close(errs)
+
+		if err := streamPages(ctx, client, endpoint, opts, results); err != nil {
+			errs <- err
+		}
+	}()
+
+	return results, errs
+}
+
+// streamPage is the outcome of requesting a single page in streamPages.
+type streamPage[T any] struct {
+	data []T
+	err  error
+}
+
+func streamPages[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+	results chan<- T,
+) error {
+	// Stop any in-flight page requests once streaming ends
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	fetch := func(page int) streamPage[T] {
+		response, err := getPaginatedPage[T](fetchCtx, client, endpoint, cloneListOptions(opts), page)
+		if err != nil {
+			// Report cancellation as-is rather than as a wrapped request error
+			if ctx.Err() != nil {
+				return streamPage[T]{err: ctx.Err()}
+			}
+
+			return streamPage[T]{err: err}
+		}
+
+		return streamPage[T]{data: response.Data}
+	}
+
+	send := func(data []T) error {
+		for _, item := range data {
+			select {
+			case results <- item:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		return nil
+	}
+
+	firstOpts := cloneListOptions(opts)
+	pageDefined := firstOpts.Page > 0
+
+	startingPage := 1
+	if pageDefined {
+		startingPage = firstOpts.Page
+	}
+
+	response, err := getPaginatedPage[T](fetchCtx, client, endpoint, firstOpts, startingPage)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return err
+	}
+
+	if err := send(response.Data); err != nil || pageDefined {
+		return err
+	}
+
+	concurrency := client.GetStreamConcurrency()
+
+	// Each remaining page gets a buffered channel so requests can complete out of order
+	// while results are still sent in page order. A slot is released once its page
+	// has been sent, which bounds the number of pages held in memory.
+	pending := make(chan chan streamPage[T], concurrency)
+	slots := make(chan struct{}, concurrency)
+
+	go func() {
+		defer close(pending)
+
+		for page := startingPage + 1; page <= response.Pages; page++ {
+			select {
+			case slots <- struct{}{}:
+			case <-fetchCtx.Done():
+				return
+			}
+
+			pageResult := make(chan streamPage[T], 1)
+			pending <- pageResult
+
+			go func(page int) {
+				pageResult <- fetch(page)
+			}(page)
+		}
+	}()
+
+	for pageResult := range pending {
+		var result streamPage[T]
+
+		select {
+		case result = <-pageResult:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if result.err != nil {
+			return result.err
+		}
+
+		if err := send(result.data); err != nil {
+			return err
+		}
+
+		<-slots
+	}
+
+	return ctx.Err()
+}
+
+// cloneListOptions returns a copy of opts, or empty ListOptions if opts is nil,
+// that can be modified while requesting pages without affecting the caller.
+func cloneListOptions(opts *ListOptions) *ListOptions {
+	if opts == nil {
+		return &ListOptions{PageOptions: &PageOptions{}}
+	}
+
+	result := *opts
+
+	if opts.PageOptions != nil {
+		pageOpts := *opts.PageOptions
+		result.PageOptions = &pageOpts
+	} else {
+		result.PageOptions = &PageOptions{}
+	}
+
+	return &result
+}
+
 func applyListOptionsToRequest(opts *ListOptions, req *resty.Request) error {
 	if opts == nil {
 		return nil
@@ -38,6 +38,32 @@ type PlacementGroup struct {
 	Migrations           *PlacementGroupMigrations `json:"migrations"`
 }
 
+// HasMember returns whether the Linode with the given ID is a member of the placement group.
+// This can be used to confirm placement after calling AssignPlacementGroupLinodes.
+func (pg PlacementGroup) HasMember(linodeID int) bool {
+	for _, m := range pg.Members {
+		if m.LinodeID == linodeID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NonCompliantMembers returns the IDs of member Linodes that do not currently
+// satisfy the placement group's affinity policy.
+func (pg PlacementGroup) NonCompliantMembers() []int {
+	var result []int
+
+	for _, m := range pg.Members {
+		if !m.IsCompliant {
+			result = append(result, m.LinodeID)
+		}
+	}
+
+	return result
+}
+
 // PlacementGroupMigrations represent the instances that are being migrated to or from the placement group.
 type PlacementGroupMigrations struct {
 	Inbound  []PlacementGroupMigrationInstance `json:"inbound"`
@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -193,6 +194,17 @@ func (c *Client) CreatePostgresDatabase(ctx context.Context, opts PostgresCreate
 	return doPOSTRequest[PostgresDatabase](ctx, c, "databases/postgresql/instances", opts)
 }
 
+// CreatePostgresDatabaseFork creates a new Postgres Database forked from opts.Fork.Source as it was at
+// opts.Fork.RestoreTime, or at the latest available point if RestoreTime is nil. The returned
+// Database will be provisioning; use WaitForDatabaseStatus to wait for it to become active.
+func (c *Client) CreatePostgresDatabaseFork(ctx context.Context, opts PostgresCreateOptions) (*PostgresDatabase, error) {
+	if opts.Fork == nil || opts.Fork.Source == 0 {
+		return nil, errors.New("a fork source database must be provided")
+	}
+
+	return doPOSTRequest[PostgresDatabase](ctx, c, "databases/postgresql/instances", opts)
+}
+
 // DeletePostgresDatabase deletes an existing Postgres Database with the given id
 func (c *Client) DeletePostgresDatabase(ctx context.Context, databaseID int) error {
 	e := formatAPIPath("databases/postgresql/instances/%d", databaseID)
@@ -201,10 +213,117 @@ func (c *Client) DeletePostgresDatabase(ctx context.Context, databaseID int) err
 
 // UpdatePostgresDatabase updates the given Postgres Database with the provided opts, returns the PostgresDatabase with the new settings
 func (c *Client) UpdatePostgresDatabase(ctx context.Context, databaseID int, opts PostgresUpdateOptions) (*PostgresDatabase, error) {
+	if opts.AllowList != nil {
+		if err := validateDatabaseAllowList(*opts.AllowList); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Updates != nil {
+		if err := opts.Updates.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	e := formatAPIPath("databases/postgresql/instances/%d", databaseID)
 	return doPUTRequest[PostgresDatabase](ctx, c, e, opts)
 }
 
+// GetPostgresDatabaseAllowList returns the IP addresses and CIDR ranges allowed to connect
+// to the given Postgres Database.
+func (c *Client) GetPostgresDatabaseAllowList(ctx context.Context, databaseID int) ([]string, error) {
+	db, err := c.GetPostgresDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.AllowList, nil
+}
+
+// UpdatePostgresDatabaseAllowList replaces the full allow list of the given Postgres Database.
+// Any entry not in allowList loses access, and an empty allowList blocks all connections.
+// Use AddPostgresDatabaseAllowedIP or RemovePostgresDatabaseAllowedIP to change a single entry.
+func (c *Client) UpdatePostgresDatabaseAllowList(ctx context.Context, databaseID int, allowList []string) ([]string, error) {
+	if allowList == nil {
+		allowList = []string{}
+	}
+
+	db, err := c.UpdatePostgresDatabase(ctx, databaseID, PostgresUpdateOptions{AllowList: &allowList})
+	if err != nil {
+		return nil, err
+	}
+
+	return db.AllowList, nil
+}
+
+// AddPostgresDatabaseAllowedIP adds an IP address or CIDR range to the allow list of the given
+// Postgres Database and returns the resulting allow list. The allow list is read and then
+// replaced, so concurrent changes to it may be lost. No update is made if the entry is
+// already present.
+func (c *Client) AddPostgresDatabaseAllowedIP(ctx context.Context, databaseID int, entry string) ([]string, error) {
+	if err := validateDatabaseAllowList([]string{entry}); err != nil {
+		return nil, err
+	}
+
+	allowList, err := c.GetPostgresDatabaseAllowList(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowList, changed := addToDatabaseAllowList(allowList, entry)
+	if !changed {
+		return allowList, nil
+	}
+
+	return c.UpdatePostgresDatabaseAllowList(ctx, databaseID, allowList)
+}
+
+// RemovePostgresDatabaseAllowedIP removes an IP address or CIDR range from the allow list of the
+// given Postgres Database and returns the resulting allow list. The allow list is read and then
+// replaced, so concurrent changes to it may be lost. No update is made if the entry is
+// not present.
+func (c *Client) RemovePostgresDatabaseAllowedIP(ctx context.Context, databaseID int, entry string) ([]string, error) {
+	allowList, err := c.GetPostgresDatabaseAllowList(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowList, changed := removeFromDatabaseAllowList(allowList, entry)
+	if !changed {
+		return allowList, nil
+	}
+
+	return c.UpdatePostgresDatabaseAllowList(ctx, databaseID, allowList)
+}
+
+// GetPostgresDatabaseMaintenanceWindow returns the maintenance window of the given Postgres Database,
+// including any pending updates.
+func (c *Client) GetPostgresDatabaseMaintenanceWindow(ctx context.Context, databaseID int) (*DatabaseMaintenanceWindow, error) {
+	db, err := c.GetPostgresDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &db.Updates, nil
+}
+
+// UpdatePostgresDatabaseMaintenanceWindow sets the maintenance window of the given Postgres Database.
+// Use PatchPostgresDatabase to apply pending updates immediately.
+func (c *Client) UpdatePostgresDatabaseMaintenanceWindow(
+	ctx context.Context,
+	databaseID int,
+	window DatabaseMaintenanceWindow,
+) (*DatabaseMaintenanceWindow, error) {
+	window.Pending = nil
+
+	db, err := c.UpdatePostgresDatabase(ctx, databaseID, PostgresUpdateOptions{Updates: &window})
+	if err != nil {
+		return nil, err
+	}
+
+	return &db.Updates, nil
+}
+
 // PatchPostgresDatabase applies security patches and updates to the underlying operating system of the Managed Postgres Database
 func (c *Client) PatchPostgresDatabase(ctx context.Context, databaseID int) error {
 	e := formatAPIPath("databases/postgresql/instances/%d/patch", databaseID)
@@ -217,7 +336,11 @@ func (c *Client) GetPostgresDatabaseCredentials(ctx context.Context, databaseID
 	return doGETRequest[PostgresDatabaseCredential](ctx, c, e)
 }
 
-// ResetPostgresDatabaseCredentials returns the Root Credentials for the given Postgres Database (may take a few seconds to work)
+// ResetPostgresDatabaseCredentials rotates the Root Credentials for the given Postgres Database.
+// The rotation is asynchronous: a nil error means the request was accepted and the new
+// credentials will be returned by GetPostgresDatabaseCredentials after a few seconds, while a
+// non-nil error means the rotation was not started. Existing connections using the old
+// credentials will be dropped.
 func (c *Client) ResetPostgresDatabaseCredentials(ctx context.Context, databaseID int) error {
 	e := formatAPIPath("databases/postgresql/instances/%d/credentials/reset", databaseID)
 	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
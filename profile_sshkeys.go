@@ -2,10 +2,15 @@ package linodego
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
+	"golang.org/x/crypto/ssh"
 )
 
 // SSHKey represents a SSHKey object
@@ -71,8 +76,44 @@ func (c *Client) GetSSHKey(ctx context.Context, keyID int) (*SSHKey, error) {
 	return doGETRequest[SSHKey](ctx, c, e)
 }
 
-// CreateSSHKey creates a SSHKey
+// ValidateSSHPublicKey checks that key is a single OpenSSH public key line of the form
+// "<type> <base64 key> [comment]" whose type matches the encoded key. Options such as
+// those allowed in authorized_keys files are not accepted.
+func ValidateSSHPublicKey(key string) error {
+	if strings.ContainsAny(key, "\r\n") {
+		return errors.New("invalid SSH public key: must be a single line")
+	}
+
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return errors.New("invalid SSH public key: expected \"<type> <base64 key> [comment]\"")
+	}
+
+	keyType, encoded := fields[0], fields[1]
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("invalid SSH public key: %w", err)
+	}
+
+	publicKey, err := ssh.ParsePublicKey(decoded)
+	if err != nil {
+		return fmt.Errorf("invalid SSH public key: %w", err)
+	}
+
+	if publicKey.Type() != keyType {
+		return fmt.Errorf("invalid SSH public key: type %q does not match encoded key type %q", keyType, publicKey.Type())
+	}
+
+	return nil
+}
+
+// CreateSSHKey creates a SSHKey. The key is validated with ValidateSSHPublicKey before it is sent.
 func (c *Client) CreateSSHKey(ctx context.Context, opts SSHKeyCreateOptions) (*SSHKey, error) {
+	if err := ValidateSSHPublicKey(opts.SSHKey); err != nil {
+		return nil, err
+	}
+
 	return doPOSTRequest[SSHKey](ctx, c, "profile/sshkeys", opts)
 }
 
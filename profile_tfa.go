@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"net/url"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -21,9 +22,29 @@ type ConfirmTwoFactorOptions struct {
 
 // ConfirmTwoFactorResponse contains fields returned by ConfirmTwoFactor
 type ConfirmTwoFactorResponse struct {
+	// Scratch is a one-time recovery code for when the TOTP device is unavailable.
+	// It is only returned once and should be stored securely.
 	Scratch string `json:"scratch"`
 }
 
+// ProvisioningURI returns an otpauth:// URI for the secret that can be rendered as a
+// QR code or imported into an authenticator app. issuer and account label the entry
+// in the app, e.g. "Linode" and the user's username.
+func (s TwoFactorSecret) ProvisioningURI(issuer, account string) string {
+	query := url.Values{}
+	query.Set("secret", s.Secret)
+	query.Set("issuer", issuer)
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
+
 func (s *TwoFactorSecret) UnmarshalJSON(b []byte) error {
 	type Mask TwoFactorSecret
 
@@ -3,6 +3,8 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -84,6 +86,69 @@ func (i Token) GetUpdateOptions() (o TokenUpdateOptions) {
 	return
 }
 
+// ExpiresWithin returns true if the token expires within d of the current time,
+// including tokens that have already expired. Tokens without an expiry never expire.
+// This is useful for deciding when to refresh short-lived tokens such as those
+// returned by CreateChildAccountToken.
+func (i Token) ExpiresWithin(d time.Duration) bool {
+	return i.Expiry != nil && time.Until(*i.Expiry) <= d
+}
+
+// ScopeList returns the token's scopes split into individual entries,
+// e.g. []string{"linodes:read_write", "domains:read_only"} or []string{"*"}.
+func (i Token) ScopeList() []string {
+	return strings.FieldsFunc(i.Scopes, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+// HasScope returns whether the token grants the given scope. The scope may be given as
+// "resource:read_only", "resource:read_write" or just "resource" for any level of access.
+// The "*" scope grants everything, and read_write access to a resource implies read_only.
+func (i Token) HasScope(scope string) bool {
+	resource, level, _ := strings.Cut(scope, ":")
+
+	for _, s := range i.ScopeList() {
+		if s == "*" {
+			return true
+		}
+
+		grantedResource, grantedLevel, _ := strings.Cut(s, ":")
+		if grantedResource != resource {
+			continue
+		}
+
+		if level == "" || grantedLevel == level || grantedLevel == "read_write" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetProfileToken returns the personal access token the client is currently authenticated with,
+// found by matching the configured token against the prefixes returned by ListTokens.
+// OAuth tokens issued to applications are not listed and cannot be introspected this way.
+func (c *Client) GetProfileToken(ctx context.Context) (*Token, error) {
+	current := strings.TrimPrefix(c.resty.Header.Get("Authorization"), "Bearer ")
+	if current == "" {
+		return nil, errors.New("client has no token configured")
+	}
+
+	tokens, err := c.ListTokens(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tokens {
+		if t.Token != "" && strings.HasPrefix(current, t.Token) {
+			return &t, nil
+		}
+	}
+
+	return nil, errors.New("the client's token was not found in the profile's tokens")
+}
+
 // ListTokens lists Tokens
 func (c *Client) ListTokens(ctx context.Context, opts *ListOptions) ([]Token, error) {
 	return getPaginatedResults[Token](ctx, c, "profile/tokens", opts)
@@ -86,7 +86,7 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
 		return result.([]Region), nil
 	}
 
@@ -95,7 +95,7 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -104,7 +104,7 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error) {
 	e := formatAPIPath("regions/%s", regionID)
 
-	if result := c.getCachedResponse(e); result != nil {
+	if result := c.getCachedResponse(ctx, e); result != nil {
 		result := result.(Region)
 		return &result, nil
 	}
@@ -114,7 +114,7 @@ func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, e, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -20,7 +20,7 @@ func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions)
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
 		return result.([]RegionAvailability), nil
 	}
 
@@ -29,7 +29,7 @@ func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions)
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -38,7 +38,7 @@ func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions)
 func (c *Client) GetRegionAvailability(ctx context.Context, regionID string) (*RegionAvailability, error) {
 	e := formatAPIPath("regions/%s/availability", regionID)
 
-	if result := c.getCachedResponse(e); result != nil {
+	if result := c.getCachedResponse(ctx, e); result != nil {
 		result := result.(RegionAvailability)
 		return &result, nil
 	}
@@ -48,7 +48,7 @@ func (c *Client) GetRegionAvailability(ctx context.Context, regionID string) (*R
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, e, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -3,7 +3,10 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"reflect"
 )
@@ -26,8 +29,6 @@ func getPaginatedResults[T any](
 	endpoint string,
 	opts *ListOptions,
 ) ([]T, error) {
-	var resultType paginatedResponse[T]
-
 	result := make([]T, 0)
 
 	if opts == nil {
@@ -41,29 +42,11 @@ func getPaginatedResults[T any](
 	// Makes a request to a particular page and
 	// appends the response to the result
 	handlePage := func(page int) error {
-		// Override the page to be applied in applyListOptionsToRequest(...)
-		opts.Page = page
-
-		// This request object cannot be reused for each page request
-		// because it can lead to possible data corruption
-		req := client.R(ctx).SetResult(resultType)
-
-		// Apply all user-provided list options to the request
-		if err := applyListOptionsToRequest(opts, req); err != nil {
-			return err
-		}
-
-		res, err := coupleAPIErrors(req.Get(endpoint))
+		response, err := getPaginatedPage[T](ctx, client, endpoint, opts, page)
 		if err != nil {
 			return err
 		}
 
-		response := res.Result().(*paginatedResponse[T])
-
-		opts.Page = page
-		opts.Pages = response.Pages
-		opts.Results = response.Results
-
 		result = append(result, response.Data...)
 		return nil
 	}
@@ -97,6 +80,115 @@ func getPaginatedResults[T any](
 	return result, nil
 }
 
+// getPaginatedPage requests a single page from the given paginated endpoint
+// and updates the PageOptions of opts to reflect the response.
+// opts and opts.PageOptions must not be nil.
+func getPaginatedPage[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+	page int,
+) (*paginatedResponse[T], error) {
+	var resultType paginatedResponse[T]
+
+	// Override the page to be applied in applyListOptionsToRequest(...)
+	opts.Page = page
+
+	// This request object cannot be reused for each page request
+	// because it can lead to possible data corruption
+	req := client.R(ctx).SetResult(resultType)
+
+	// Apply all user-provided list options to the request
+	if err := applyListOptionsToRequest(opts, req); err != nil {
+		return nil, err
+	}
+
+	res, err := coupleAPIErrors(req.Get(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	response := res.Result().(*paginatedResponse[T])
+
+	opts.Page = page
+	opts.Pages = response.Pages
+	opts.Results = response.Results
+
+	return response, nil
+}
+
+// pageIterator lazily walks the pages of a paginated endpoint,
+// requesting each page only when the previous one has been exhausted.
+type pageIterator[T any] struct {
+	client   *Client
+	endpoint string
+	opts     *ListOptions
+
+	current  []T
+	index    int
+	nextPage int
+	done     bool
+	err      error
+}
+
+func newPageIterator[T any](client *Client, endpoint string, opts *ListOptions) *pageIterator[T] {
+	// Copy the options so the caller's ListOptions are not mutated
+	// as pages are requested.
+	iterOpts := cloneListOptions(opts)
+
+	startingPage := 1
+	if iterOpts.Page > 0 {
+		startingPage = iterOpts.Page
+	}
+
+	return &pageIterator[T]{
+		client:   client,
+		endpoint: endpoint,
+		opts:     iterOpts,
+		index:    -1,
+		nextPage: startingPage,
+	}
+}
+
+// next advances the iterator, requesting the next page if necessary.
+func (it *pageIterator[T]) next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+
+	for it.index >= len(it.current) {
+		if it.done {
+			return false
+		}
+
+		response, err := getPaginatedPage[T](ctx, it.client, it.endpoint, it.opts, it.nextPage)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.current = response.Data
+		it.index = 0
+		it.done = it.nextPage >= response.Pages
+		it.nextPage++
+	}
+
+	return true
+}
+
+// value returns the element at the current position of the iterator.
+func (it *pageIterator[T]) value() T {
+	if it.index < 0 || it.index >= len(it.current) {
+		var zero T
+		return zero
+	}
+
+	return it.current[it.index]
+}
+
 // doGETRequest runs a GET request using the given client and API endpoint,
 // and returns the result
 func doGETRequest[T any](
@@ -115,6 +207,70 @@ func doGETRequest[T any](
 	return r.Result().(*T), nil
 }
 
+// doGETRawRequest runs a GET request using the given client and API endpoint,
+// and returns the response body as a stream without buffering it in memory.
+// API errors are coupled as usual for non-2xx responses.
+// The caller owns the returned reader and must close it.
+func doGETRawRequest(
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+) (io.ReadCloser, error) {
+	req := client.R(ctx).
+		SetHeader("Accept", "application/json").
+		SetDoNotParseResponse(true)
+
+	// The body is streamed after the request completes, so any
+	// WithTimeout context is released once it has been closed.
+	releaseTimeout := detachRequestTimeout(req)
+
+	r, err := req.Get(endpoint)
+	if err != nil {
+		releaseTimeout()
+		return nil, NewError(err)
+	}
+
+	resp := r.RawResponse
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return &releasingReadCloser{ReadCloser: resp.Body, release: releaseTimeout}, nil
+	}
+
+	defer releaseTimeout()
+	defer resp.Body.Close()
+
+	if _, err := coupleAPIErrorsHTTP(resp, nil); err != nil {
+		// coupleAPIErrorsHTTP returns Error values; return a pointer so
+		// callers can match it with IsNotFound and ErrHasStatus.
+		var apiErr Error
+		if errors.As(err, &apiErr) {
+			apiErr.Response = resp
+			return nil, &apiErr
+		}
+
+		// Any other error means the body could not be decoded, such as an
+		// empty body, so report the status of the response below instead.
+	}
+
+	// The API responded with an error status but no error reasons
+	return nil, &Error{
+		Code:      resp.StatusCode,
+		Message:   http.StatusText(resp.StatusCode),
+		Response:  resp,
+		RequestID: resp.Header.Get(requestIDHeaderName),
+	}
+}
+
+// releasingReadCloser calls release once the wrapped ReadCloser is closed.
+type releasingReadCloser struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releasingReadCloser) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
+
 // doPOSTRequest runs a PUT request using the given client, API endpoint,
 // and options/body.
 func doPOSTRequest[T, O any](
@@ -171,6 +327,34 @@ func doPOSTRequestNoRequestResponseBody(
 	return doPOSTRequestNoResponseBody(ctx, client, endpoint, struct{}{})
 }
 
+// doMultipartPOSTRequest runs a multipart/form-data POST request using the given
+// client and API endpoint, sending fields as form values and file as a file
+// part named fileField, and returns the result.
+func doMultipartPOSTRequest[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	fields map[string]string,
+	fileField, filename string,
+	file io.Reader,
+) (*T, error) {
+	var resultType T
+
+	// resty replaces the default JSON Content-Type with multipart/form-data
+	// and the generated boundary once a file part is set.
+	req := client.R(ctx).
+		SetResult(&resultType).
+		SetMultipartFormData(fields).
+		SetFileReader(fileField, filename, file)
+
+	r, err := coupleAPIErrors(req.Post(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*T), nil
+}
+
 // doPUTRequest runs a PUT request using the given client, API endpoint,
 // and options/body.
 func doPUTRequest[T, O any](
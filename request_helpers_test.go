@@ -3,10 +3,13 @@ package linodego
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
+	"mime"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -58,6 +61,72 @@ func TestRequestHelpers_get(t *testing.T) {
 	}
 }
 
+func TestRequestHelpers_getRaw(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		httpmock.NewStringResponder(http.StatusOK, "raw response body"))
+
+	body, err := doGETRawRequest(context.Background(), client, "/foo/bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	contents, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(contents) != "raw response body" {
+		t.Fatalf("unexpected response body: %s", contents)
+	}
+}
+
+func TestRequestHelpers_getRawError(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		httpmock.NewJsonResponderOrPanic(http.StatusNotFound, APIError{
+			Errors: []APIErrorReason{{Reason: "Not found"}},
+		}))
+
+	body, err := doGETRawRequest(context.Background(), client, "/foo/bar")
+	if body != nil {
+		t.Fatal("expected nil body on error")
+	}
+
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestRequestHelpers_getRawErrorNoReasons(t *testing.T) {
+	for name, body := range map[string]string{
+		"empty body":  "",
+		"no reasons":  `{"errors": []}`,
+		"not decoded": "not json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := testutil.CreateMockClient(t, NewClient)
+
+			httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+				httpmock.NewStringResponder(http.StatusNotFound, body).
+					HeaderSet(http.Header{"Content-Type": []string{"application/json"}}))
+
+			_, err := doGETRawRequest(context.Background(), client, "/foo/bar")
+
+			if !IsNotFound(err) {
+				t.Fatalf("expected not found error, got %T: %v", err, err)
+			}
+
+			if err.Error() != "[404] Not Found" {
+				t.Fatalf("unexpected error message: %s", err)
+			}
+		})
+	}
+}
+
 func TestRequestHelpers_post(t *testing.T) {
 	client := testutil.CreateMockClient(t, NewClient)
 
@@ -102,6 +171,48 @@ func TestRequestHelpers_postNoOptions(t *testing.T) {
 	}
 }
 
+func TestRequestHelpers_postMultipart(t *testing.T) {
+	client := testutil.CreateMockClient(t, NewClient)
+
+	httpmock.RegisterRegexpResponder("POST", testutil.MockRequestURL("/foo/bar"),
+		func(req *http.Request) (*http.Response, error) {
+			mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+			require.NoError(t, err)
+			require.Equal(t, "multipart/form-data", mediaType)
+
+			require.NoError(t, req.ParseMultipartForm(1<<20))
+			require.Equal(t, "test", req.FormValue("foo"))
+
+			file, header, err := req.FormFile("upload")
+			require.NoError(t, err)
+			defer file.Close()
+
+			contents, err := io.ReadAll(file)
+			require.NoError(t, err)
+			require.Equal(t, "file.txt", header.Filename)
+			require.Equal(t, "file contents", string(contents))
+
+			return httpmock.NewJsonResponse(http.StatusOK, &testResponse)
+		})
+
+	result, err := doMultipartPOSTRequest[testResultType](
+		context.Background(),
+		client,
+		"/foo/bar",
+		map[string]string{"foo": "test"},
+		"upload",
+		"file.txt",
+		strings.NewReader("file contents"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(*result, testResponse) {
+		t.Fatalf("actual response does not equal desired response: %s", cmp.Diff(result, testResponse))
+	}
+}
+
 func TestRequestHelpers_put(t *testing.T) {
 	client := testutil.CreateMockClient(t, NewClient)
 
@@ -0,0 +1,103 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// requestObserver is notified of each API request once it completes, including
+// requests that fail. It is implemented by the request logger and metrics hook.
+type requestObserver interface {
+	// enabled returns whether the observer is configured, so that request start
+	// times are only recorded while they are needed.
+	enabled() bool
+	observe(req completedRequest)
+}
+
+// completedRequest describes an API request passed to a requestObserver.
+type completedRequest struct {
+	request  *resty.Request
+	response *resty.Response
+
+	// err is the error that caused the request to fail without an API response, if any.
+	err error
+
+	// path is the URL path of the request, e.g. /v4/linode/instances.
+	path string
+
+	// statusCode is the HTTP status code of the final response, or 0 if no response was received.
+	statusCode int
+
+	// duration is the total time spent on the request including retries,
+	// or 0 if no observer was enabled when the request started.
+	duration time.Duration
+
+	retries int
+}
+
+type requestStartKey struct{}
+
+// registerRequestObservers adds the resty hooks that record when each request starts
+// and notify observers when it completes.
+func registerRequestObservers(rc *resty.Client, observers ...requestObserver) {
+	rc.OnBeforeRequest(func(_ *resty.Client, r *resty.Request) error {
+		if r.Attempt <= 1 && slices.ContainsFunc(observers, requestObserver.enabled) {
+			r.SetContext(context.WithValue(r.Context(), requestStartKey{}, time.Now()))
+		}
+
+		return nil
+	})
+
+	rc.OnSuccess(func(_ *resty.Client, resp *resty.Response) {
+		notifyRequestObservers(observers, resp.Request, resp, nil)
+	})
+
+	rc.OnError(func(r *resty.Request, err error) {
+		var resp *resty.Response
+
+		var respErr *resty.ResponseError
+		if errors.As(err, &respErr) {
+			resp = respErr.Response
+			err = respErr.Err
+		}
+
+		notifyRequestObservers(observers, r, resp, err)
+	})
+}
+
+func notifyRequestObservers(observers []requestObserver, r *resty.Request, resp *resty.Response, err error) {
+	if r == nil || !slices.ContainsFunc(observers, requestObserver.enabled) {
+		return
+	}
+
+	req := completedRequest{
+		request:  r,
+		response: resp,
+		err:      err,
+		path:     r.URL,
+		retries:  max(r.Attempt-1, 0),
+	}
+
+	if u, parseErr := url.Parse(r.URL); parseErr == nil {
+		req.path = u.Path
+	}
+
+	if start, ok := r.Context().Value(requestStartKey{}).(time.Time); ok {
+		req.duration = time.Since(start)
+	}
+
+	if resp != nil && resp.RawResponse != nil {
+		req.statusCode = resp.StatusCode()
+	}
+
+	for _, observer := range observers {
+		if observer.enabled() {
+			observer.observe(req)
+		}
+	}
+}
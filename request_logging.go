@@ -0,0 +1,225 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// StructuredLogger is a leveled logger that accepts alternating key-value pairs,
+// set with Client.SetStructuredLogger. It is satisfied by *slog.Logger.
+//
+// If the logger also implements DebugContext, InfoContext, WarnContext and ErrorContext,
+// as *slog.Logger does, those are called with the request's context instead.
+type StructuredLogger interface {
+	Debug(msg string, keysAndValues ...any)
+	Info(msg string, keysAndValues ...any)
+	Warn(msg string, keysAndValues ...any)
+	Error(msg string, keysAndValues ...any)
+}
+
+type structuredContextLogger interface {
+	DebugContext(ctx context.Context, msg string, keysAndValues ...any)
+	InfoContext(ctx context.Context, msg string, keysAndValues ...any)
+	WarnContext(ctx context.Context, msg string, keysAndValues ...any)
+	ErrorContext(ctx context.Context, msg string, keysAndValues ...any)
+}
+
+// LogRedactor returns a copy of a request or response body that is safe to log,
+// set with Client.SetLogBodies.
+type LogRedactor func(body []byte) []byte
+
+// redactedValue replaces sensitive values in bodies redacted by RedactSensitiveFields.
+const redactedValue = "[REDACTED]"
+
+// sensitiveFieldNames are the substrings of JSON keys whose values are redacted
+// by RedactSensitiveFields.
+var sensitiveFieldNames = []string{"pass", "token", "secret", "private_key", "access_key"}
+
+// RedactSensitiveFields is a LogRedactor that replaces the values of JSON object keys
+// containing "pass", "token", "secret", "private_key" or "access_key", at any depth,
+// such as root_pass and client_secret. Bodies that are not JSON are omitted entirely.
+func RedactSensitiveFields(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return []byte("[non-JSON body omitted]")
+	}
+
+	redacted, err := json.Marshal(redactSensitiveValue(decoded))
+	if err != nil {
+		return []byte("[non-JSON body omitted]")
+	}
+
+	return redacted
+}
+
+func redactSensitiveValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSensitiveFieldName(key) {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactSensitiveValue(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactSensitiveValue(item)
+		}
+	}
+
+	return value
+}
+
+func isSensitiveFieldName(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, name := range sensitiveFieldNames {
+		if strings.Contains(key, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type requestLogger struct {
+	mu       sync.RWMutex
+	logger   StructuredLogger
+	redactor LogRedactor
+}
+
+func (l *requestLogger) setLogger(logger StructuredLogger) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.logger = logger
+}
+
+func (l *requestLogger) setRedactor(redactor LogRedactor) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.redactor = redactor
+}
+
+func (l *requestLogger) get() (StructuredLogger, LogRedactor) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.logger, l.redactor
+}
+
+func (l *requestLogger) enabled() bool {
+	logger, _ := l.get()
+	return logger != nil
+}
+
+func (l *requestLogger) observe(req completedRequest) {
+	logger, redactor := l.get()
+	if logger == nil {
+		return
+	}
+
+	r, resp := req.request, req.response
+
+	keysAndValues := []any{"method", r.Method, "path", req.path}
+
+	if req.duration > 0 {
+		keysAndValues = append(keysAndValues, "duration", req.duration)
+	}
+
+	if req.retries > 0 {
+		keysAndValues = append(keysAndValues, "retries", req.retries)
+	}
+
+	if resp != nil && resp.RawResponse != nil {
+		keysAndValues = append(keysAndValues, "status", req.statusCode)
+
+		if requestID := resp.Header().Get(requestIDHeaderName); requestID != "" {
+			keysAndValues = append(keysAndValues, "request_id", requestID)
+		}
+	}
+
+	ctx := r.Context()
+	ctxLogger, hasContext := logger.(structuredContextLogger)
+
+	switch {
+	case req.err != nil:
+		errorKeysAndValues := append(slices.Clone(keysAndValues), "error", req.err)
+		if hasContext {
+			ctxLogger.ErrorContext(ctx, "linodego request failed", errorKeysAndValues...)
+		} else {
+			logger.Error("linodego request failed", errorKeysAndValues...)
+		}
+	case req.statusCode >= 400:
+		if hasContext {
+			ctxLogger.WarnContext(ctx, "linodego request returned an error", keysAndValues...)
+		} else {
+			logger.Warn("linodego request returned an error", keysAndValues...)
+		}
+	default:
+		if hasContext {
+			ctxLogger.InfoContext(ctx, "linodego request", keysAndValues...)
+		} else {
+			logger.Info("linodego request", keysAndValues...)
+		}
+	}
+
+	if redactor == nil {
+		return
+	}
+
+	// Bodies are logged in a separate entry at debug level
+	bodyKeysAndValues := slices.Clone(keysAndValues)
+
+	if body := requestBodyBytes(r.Body); len(body) > 0 {
+		bodyKeysAndValues = append(bodyKeysAndValues, "request_body", string(redactor(body)))
+	}
+
+	if resp != nil {
+		if body := resp.Body(); len(body) > 0 {
+			bodyKeysAndValues = append(bodyKeysAndValues, "response_body", string(redactor(body)))
+		}
+	}
+
+	if len(bodyKeysAndValues) == len(keysAndValues) {
+		return
+	}
+
+	if hasContext {
+		ctxLogger.DebugContext(ctx, "linodego request bodies", bodyKeysAndValues...)
+	} else {
+		logger.Debug("linodego request bodies", bodyKeysAndValues...)
+	}
+}
+
+// requestBodyBytes returns the body set on a resty request in its encoded form.
+func requestBodyBytes(body any) []byte {
+	switch b := body.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return b
+	case string:
+		return []byte(b)
+	case io.Reader:
+		return nil
+	default:
+		encoded, err := json.Marshal(b)
+		if err != nil {
+			return nil
+		}
+
+		return encoded
+	}
+}
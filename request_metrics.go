@@ -0,0 +1,69 @@
+package linodego
+
+import (
+	"sync"
+	"time"
+)
+
+// RequestMetrics contains information about a completed API request,
+// passed to the hook configured with Client.SetRequestMetricsHook.
+type RequestMetrics struct {
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Endpoint is the URL path of the request, e.g. /v4/linode/instances.
+	Endpoint string
+
+	// StatusCode is the HTTP status code of the final response,
+	// or 0 if no response was received.
+	StatusCode int
+
+	// Duration is the total time spent on the request, including retries.
+	Duration time.Duration
+
+	// Retries is the number of times the request was retried.
+	Retries int
+
+	// Err is the error that caused the request to fail without an API response,
+	// if any. API error responses are reported through StatusCode.
+	Err error
+}
+
+type requestMetricsHook struct {
+	mu   sync.RWMutex
+	hook func(info RequestMetrics)
+}
+
+func (h *requestMetricsHook) set(hook func(info RequestMetrics)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.hook = hook
+}
+
+func (h *requestMetricsHook) get() func(info RequestMetrics) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.hook
+}
+
+func (h *requestMetricsHook) enabled() bool {
+	return h.get() != nil
+}
+
+func (h *requestMetricsHook) observe(req completedRequest) {
+	hook := h.get()
+	if hook == nil {
+		return
+	}
+
+	hook(RequestMetrics{
+		Method:     req.request.Method,
+		Endpoint:   req.path,
+		StatusCode: req.statusCode,
+		Duration:   req.duration,
+		Retries:    req.retries,
+		Err:        req.err,
+	})
+}
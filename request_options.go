@@ -0,0 +1,147 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RequestOption configures a single API request. RequestOptions are attached
+// to a context using WithRequestOptions and are applied to every request made
+// with that context, without modifying the shared Client.
+type RequestOption func(r *resty.Request)
+
+type requestOptionsKey struct{}
+
+type idempotencyKeyContextKey struct{}
+
+// IdempotencyKeyHeaderName is the header used to send idempotency keys set with WithIdempotencyKey.
+const IdempotencyKeyHeaderName = "Idempotency-Key"
+
+// WithRequestOptions returns a copy of ctx carrying the given RequestOptions
+// in addition to any already attached to ctx. Requests made with the returned
+// context will have the options applied; requests made with other contexts are
+// unaffected, which makes this safe for use with a Client shared between goroutines.
+//
+// For example:
+//
+//	ctx := linodego.WithRequestOptions(ctx, linodego.WithHeader("X-Filter-Beta", "1"))
+//	instances, err := client.ListInstances(ctx, nil)
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	existing := requestOptionsFromContext(ctx)
+
+	combined := make([]RequestOption, 0, len(existing)+len(opts))
+	combined = append(combined, existing...)
+	combined = append(combined, opts...)
+
+	return context.WithValue(ctx, requestOptionsKey{}, combined)
+}
+
+// WithHeader returns a RequestOption that sets the given header on a request.
+// Headers set this way take precedence over headers set with Client.SetHeader.
+func WithHeader(name, value string) RequestOption {
+	return func(r *resty.Request) {
+		r.SetHeader(name, value)
+	}
+}
+
+// WithTimeout returns a RequestOption that limits each request to the given
+// duration. The timeout is derived from the request's context, so an earlier
+// deadline on the caller's context still takes precedence. Requests that span
+// multiple API calls, such as paginated lists, apply the timeout to each call.
+// The timeout is released as soon as the request completes.
+//
+// NOTE: The timeout of the http.Client used by the Client, if any, still applies.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(r *resty.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), d)
+
+		// Release any timeout already applied to this request along with this one
+		if parent, ok := r.Context().Value(requestTimeoutCancelKey{}).(context.CancelFunc); ok && parent != nil {
+			cancelTimeout := cancel
+			cancel = func() {
+				cancelTimeout()
+				parent()
+			}
+		}
+
+		r.SetContext(context.WithValue(ctx, requestTimeoutCancelKey{}, cancel))
+	}
+}
+
+type requestTimeoutCancelKey struct{}
+
+// releaseRequestTimeout cancels the contexts created for r by WithTimeout, if any.
+// It is registered as a resty hook so it runs once the request has completed.
+func releaseRequestTimeout(r *resty.Request) {
+	if r == nil {
+		return
+	}
+
+	if cancel, ok := r.Context().Value(requestTimeoutCancelKey{}).(context.CancelFunc); ok && cancel != nil {
+		cancel()
+	}
+}
+
+// detachRequestTimeout returns the function that cancels the contexts created for r by
+// WithTimeout, and stops the completion hooks from calling it. It is used for requests
+// whose response body is read after the request completes.
+func detachRequestTimeout(r *resty.Request) context.CancelFunc {
+	cancel, _ := r.Context().Value(requestTimeoutCancelKey{}).(context.CancelFunc)
+	if cancel == nil {
+		return func() {}
+	}
+
+	r.SetContext(context.WithValue(r.Context(), requestTimeoutCancelKey{}, context.CancelFunc(nil)))
+
+	return cancel
+}
+
+// WithIdempotencyKey returns a RequestOption that sends the given key in the
+// Idempotency-Key header of POST requests. The same key is sent on every retry
+// of a request, so a create that succeeded server-side is not repeated when
+// its response is lost. Use a new key for each logical operation.
+//
+// For example:
+//
+//	ctx := linodego.WithRequestOptions(ctx, linodego.WithIdempotencyKey(requestKey))
+//	instance, err := client.CreateInstance(ctx, opts)
+func WithIdempotencyKey(key string) RequestOption {
+	return func(r *resty.Request) {
+		r.SetContext(context.WithValue(r.Context(), idempotencyKeyContextKey{}, key))
+	}
+}
+
+// applyIdempotencyKey is a resty middleware that sets the Idempotency-Key
+// header on POST requests made with WithIdempotencyKey.
+func applyIdempotencyKey(_ *resty.Client, r *resty.Request) error {
+	if r.Method != http.MethodPost {
+		return nil
+	}
+
+	if key, ok := r.Context().Value(idempotencyKeyContextKey{}).(string); ok && key != "" {
+		r.SetHeader(IdempotencyKeyHeaderName, key)
+	}
+
+	return nil
+}
+
+func requestOptionsFromContext(ctx context.Context) []RequestOption {
+	if ctx == nil {
+		return nil
+	}
+
+	opts, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+
+	return opts
+}
+
+func applyRequestOptions(ctx context.Context, r *resty.Request) *resty.Request {
+	for _, opt := range requestOptionsFromContext(ctx) {
+		opt(r)
+	}
+
+	return r
+}
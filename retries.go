@@ -3,6 +3,7 @@ package linodego
 import (
 	"errors"
 	"log"
+	"math/rand/v2"
 	"net/http"
 	"strconv"
 	"time"
@@ -24,6 +25,10 @@ type RetryConditional resty.RetryConditionFunc
 // type RetryAfter func(c *resty.Client, r *resty.Response) (time.Duration, error)
 type RetryAfter resty.RetryAfterFunc
 
+// RetryClassifier determines whether a request should be retried given its
+// response and error. resp may be nil if no response was received.
+type RetryClassifier func(resp *http.Response, err error) bool
+
 // Configures resty to
 // lock until enough time has passed to retry the request as determined by the Retry-After response header.
 // If the Retry-After header is not set, we fall back to value of SetPollDelay.
@@ -36,6 +41,15 @@ func configureRetries(c *Client) {
 
 func checkRetryConditionals(c *Client) func(*resty.Response, error) bool {
 	return func(r *resty.Response, err error) bool {
+		if classifier := c.getRetryClassifier(); classifier != nil {
+			var resp *http.Response
+			if r != nil {
+				resp = r.RawResponse
+			}
+
+			return classifier(resp, err)
+		}
+
 		for _, retryConditional := range c.retryConditionals {
 			retry := retryConditional(r, err)
 			if retry {
@@ -103,3 +117,47 @@ func respectRetryAfter(client *resty.Client, resp *resty.Response) (time.Duratio
 	log.Printf("[INFO] Respecting Retry-After Header of %d (%s) (max %s)", retryAfter, duration, client.RetryMaxWaitTime)
 	return duration, nil
 }
+
+// retryBackoff returns a resty.RetryAfterFunc that respects the Retry-After header
+// if present, falling back to an exponential backoff computed from the given parameters.
+func retryBackoff(base, maxWait time.Duration, jitter float64) RetryAfter {
+	return func(client *resty.Client, resp *resty.Response) (time.Duration, error) {
+		retryAfter, err := respectRetryAfter(client, resp)
+		if err != nil || retryAfter > 0 {
+			return retryAfter, err
+		}
+
+		// Attempt is 1 for the initial request, so the first retry waits for base
+		attempt := 0
+		if resp.Request != nil && resp.Request.Attempt > 0 {
+			attempt = resp.Request.Attempt - 1
+		}
+
+		return exponentialBackoff(base, maxWait, jitter, attempt, rand.Float64), nil
+	}
+}
+
+// exponentialBackoff computes base * 2^attempt capped at maxWait, and then
+// adds a random jitter of up to jitter * delay using randFloat. Jitter is only
+// applied upward, as resty never waits less than base between retries.
+// A jitter of 0 always yields a deterministic delay.
+func exponentialBackoff(base, maxWait time.Duration, jitter float64, attempt int, randFloat func() float64) time.Duration {
+	delay := base
+	for i := 0; i < attempt && delay < maxWait; i++ {
+		delay *= 2
+	}
+
+	if delay > maxWait {
+		delay = maxWait
+	}
+
+	if jitter > 0 {
+		delay += time.Duration(float64(delay) * jitter * randFloat())
+	}
+
+	if delay > maxWait {
+		delay = maxWait
+	}
+
+	return delay
+}
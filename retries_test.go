@@ -74,3 +74,92 @@ func TestLinodeServiceMaintenanceModeRetryCondition(t *testing.T) {
 		t.Error("expected retry to be skipped due to maintenance mode header")
 	}
 }
+
+func TestRetryClassifierOverridesConditionals(t *testing.T) {
+	client := NewClient(nil)
+
+	request := resty.Request{}
+	rawResponse := http.Response{StatusCode: http.StatusTooManyRequests}
+	response := resty.Response{
+		Request:     &request,
+		RawResponse: &rawResponse,
+	}
+
+	check := checkRetryConditionals(&client)
+
+	if !check(&response, nil) {
+		t.Error("expected 429 to be retried by default")
+	}
+
+	var received *http.Response
+	client.SetRetryClassifier(func(resp *http.Response, _ error) bool {
+		received = resp
+		return false
+	})
+
+	if check(&response, nil) {
+		t.Error("expected classifier to prevent retry")
+	}
+
+	if received != &rawResponse {
+		t.Error("expected classifier to receive the raw response")
+	}
+
+	client.SetRetryClassifier(nil)
+
+	if !check(&response, nil) {
+		t.Error("expected default conditionals to apply after removing classifier")
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	half := func() float64 { return 0.5 }
+
+	for _, tc := range []struct {
+		name     string
+		base     time.Duration
+		maxWait  time.Duration
+		jitter   float64
+		attempt  int
+		rand     func() float64
+		expected time.Duration
+	}{
+		{name: "first retry", base: time.Second, maxWait: time.Minute, attempt: 0, rand: half, expected: time.Second},
+		{name: "third retry", base: time.Second, maxWait: time.Minute, attempt: 2, rand: half, expected: 4 * time.Second},
+		{name: "capped", base: time.Second, maxWait: 10 * time.Second, attempt: 10, rand: half, expected: 10 * time.Second},
+		{name: "large attempt", base: time.Second, maxWait: 10 * time.Second, attempt: 999, rand: half, expected: 10 * time.Second},
+		{name: "zero jitter ignores rand", base: time.Second, maxWait: time.Minute, attempt: 1, rand: func() float64 { return 1 }, expected: 2 * time.Second},
+		{name: "full jitter", base: time.Second, maxWait: time.Minute, jitter: 0.5, attempt: 1, rand: func() float64 { return 1 }, expected: 3 * time.Second},
+		{name: "half jitter", base: time.Second, maxWait: time.Minute, jitter: 0.5, attempt: 1, rand: half, expected: 2500 * time.Millisecond},
+		{name: "no jitter below delay", base: time.Second, maxWait: time.Minute, jitter: 0.5, attempt: 0, rand: func() float64 { return 0 }, expected: time.Second},
+		{name: "jitter capped", base: time.Second, maxWait: 2 * time.Second, jitter: 0.5, attempt: 1, rand: func() float64 { return 1 }, expected: 2 * time.Second},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if delay := exponentialBackoff(tc.base, tc.maxWait, tc.jitter, tc.attempt, tc.rand); delay != tc.expected {
+				t.Errorf("expected delay %s, got %s", tc.expected, delay)
+			}
+		})
+	}
+}
+
+func TestRetryBackoffRespectsRetryAfter(t *testing.T) {
+	client := NewClient(nil)
+
+	request := resty.Request{Attempt: 3}
+	response := resty.Response{
+		Request:     &request,
+		RawResponse: &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}},
+	}
+
+	backoff := retryBackoff(time.Second, time.Minute, 0)
+
+	if delay, err := backoff(client.resty, &response); err != nil || delay != 4*time.Second {
+		t.Errorf("expected 4s backoff, got %s (err: %v)", delay, err)
+	}
+
+	response.RawResponse.Header.Set(retryAfterHeaderName, "7")
+
+	if delay, err := backoff(client.resty, &response); err != nil || delay != 7*time.Second {
+		t.Errorf("expected Retry-After of 7s, got %s (err: %v)", delay, err)
+	}
+}
@@ -3,26 +3,38 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// ErrStackscriptPublic is returned by UpdateStackscript when the API rejects
+// making a StackScript private because it has already been made public.
+var ErrStackscriptPublic = errors.New("public StackScripts cannot be made private")
+
 // Stackscript represents a Linode StackScript
 type Stackscript struct {
-	ID                int               `json:"id"`
-	Username          string            `json:"username"`
-	Label             string            `json:"label"`
-	Description       string            `json:"description"`
-	Ordinal           int               `json:"ordinal"`
-	LogoURL           string            `json:"logo_url"`
-	Images            []string          `json:"images"`
-	DeploymentsTotal  int               `json:"deployments_total"`
-	DeploymentsActive int               `json:"deployments_active"`
-	IsPublic          bool              `json:"is_public"`
-	Mine              bool              `json:"mine"`
-	Created           *time.Time        `json:"-"`
-	Updated           *time.Time        `json:"-"`
+	ID                int        `json:"id"`
+	Username          string     `json:"username"`
+	Label             string     `json:"label"`
+	Description       string     `json:"description"`
+	Ordinal           int        `json:"ordinal"`
+	LogoURL           string     `json:"logo_url"`
+	Images            []string   `json:"images"`
+	DeploymentsTotal  int        `json:"deployments_total"`
+	DeploymentsActive int        `json:"deployments_active"`
+	IsPublic          bool       `json:"is_public"`
+	Mine              bool       `json:"mine"`
+	Created           *time.Time `json:"-"`
+	Updated           *time.Time `json:"-"`
+
+	// RevNote is the note for the current revision of the StackScript.
+	// The API does not expose the notes of previous revisions.
 	RevNote           string            `json:"rev_note"`
 	Script            string            `json:"script"`
 	UserDefinedFields *[]StackscriptUDF `json:"user_defined_fields"`
@@ -109,6 +121,308 @@ func (i Stackscript) GetUpdateOptions() StackscriptUpdateOptions {
 	}
 }
 
+// ValidateUDFResponses checks the given UDF responses against the Stackscript's
+// UserDefinedFields before deploying. Fields without a default must have a non-empty
+// response, oneOf fields must match exactly one of the allowed values and manyOf fields
+// may only contain allowed values. All invalid fields are reported in a single joined error.
+func (i Stackscript) ValidateUDFResponses(responses map[string]string) error {
+	if i.UserDefinedFields == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, udf := range *i.UserDefinedFields {
+		value, ok := responses[udf.Name]
+		if !ok || value == "" {
+			if udf.Default == "" {
+				errs = append(errs, fmt.Errorf("UDF %q is required", udf.Name))
+			}
+
+			continue
+		}
+
+		if udf.OneOf != "" && !slices.Contains(splitUDFValues(udf.OneOf), value) {
+			errs = append(errs, fmt.Errorf("UDF %q must be one of %s, got %q", udf.Name, udf.OneOf, value))
+		}
+
+		if udf.ManyOf != "" {
+			allowed := splitUDFValues(udf.ManyOf)
+			for _, v := range splitUDFValues(value) {
+				if !slices.Contains(allowed, v) {
+					errs = append(errs, fmt.Errorf("UDF %q values must be in %s, got %q", udf.Name, udf.ManyOf, v))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// splitUDFValues splits a comma-separated UDF value list, trimming whitespace.
+func splitUDFValues(values string) []string {
+	result := strings.Split(values, ",")
+	for i, v := range result {
+		result[i] = strings.TrimSpace(v)
+	}
+
+	return result
+}
+
+// DiffScript returns a unified diff of the Script of this StackScript against the Script
+// of other, e.g. a local copy that is about to be passed to UpdateStackscript.
+// An empty string is returned if the scripts are identical.
+func (i Stackscript) DiffScript(other Stackscript) string {
+	return unifiedDiff(
+		fmt.Sprintf("stackscript/%d", i.ID), fmt.Sprintf("stackscript/%d", other.ID),
+		i.Script, other.Script,
+	)
+}
+
+type diffOp struct {
+	kind   byte
+	line   string
+	aIndex int
+	bIndex int
+}
+
+// unifiedDiff returns a line-based unified diff of a and b with three lines of context.
+// Lines are compared with Myers' linear space algorithm, so memory use grows with the
+// length of the scripts while time grows with their length and the number of changed lines.
+func unifiedDiff(fromName, toName, a, b string) string {
+	if a == b {
+		return ""
+	}
+
+	const contextLines = 3
+
+	d := lineDiff{a: splitDiffLines(a), b: splitDiffLines(b)}
+	d.compare(0, len(d.a), 0, len(d.b))
+
+	ops := groupDiffOps(d.ops)
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	for start := 0; start < len(ops); {
+		if ops[start].kind == ' ' {
+			start++
+			continue
+		}
+
+		// Extend the hunk while changes are separated by no more than 2*contextLines equal lines
+		end := start
+		for k := start; k < len(ops) && k <= end+2*contextLines+1; k++ {
+			if ops[k].kind != ' ' {
+				end = k
+			}
+		}
+
+		from := max(0, start-contextLines)
+		to := min(len(ops), end+contextLines+1)
+
+		aCount, bCount := 0, 0
+
+		for _, op := range ops[from:to] {
+			if op.kind != '+' {
+				aCount++
+			}
+
+			if op.kind != '-' {
+				bCount++
+			}
+		}
+
+		aStart, bStart := ops[from].aIndex+1, ops[from].bIndex+1
+		if aCount == 0 {
+			aStart--
+		}
+
+		if bCount == 0 {
+			bStart--
+		}
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", aStart, aCount, bStart, bCount)
+
+		for _, op := range ops[from:to] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.line)
+
+			if !strings.HasSuffix(op.line, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		start = to
+	}
+
+	return sb.String()
+}
+
+// splitDiffLines splits s into lines, keeping their line endings so that
+// a missing newline at the end of s is reported as a difference.
+func splitDiffLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// groupDiffOps moves the deletions in each run of changes ahead of its insertions
+// and sets the line indexes of each operation.
+func groupDiffOps(ops []diffOp) []diffOp {
+	for start := 0; start < len(ops); {
+		end := start
+		for end < len(ops) && ops[end].kind != ' ' {
+			end++
+		}
+
+		slices.SortStableFunc(ops[start:end], func(x, y diffOp) int {
+			return int(y.kind) - int(x.kind)
+		})
+
+		start = end + 1
+	}
+
+	i, j := 0, 0
+
+	for k := range ops {
+		ops[k].aIndex, ops[k].bIndex = i, j
+
+		if ops[k].kind != '+' {
+			i++
+		}
+
+		if ops[k].kind != '-' {
+			j++
+		}
+	}
+
+	return ops
+}
+
+// lineDiff computes the operations that turn the lines of a into the lines of b
+type lineDiff struct {
+	a, b []string
+	ops  []diffOp
+}
+
+// compare appends the operations that turn a[aLo:aHi] into b[bLo:bHi].
+func (d *lineDiff) compare(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.a[aLo] == d.b[bLo] {
+		d.ops = append(d.ops, diffOp{kind: ' ', line: d.a[aLo]})
+		aLo++
+		bLo++
+	}
+
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && d.a[aHi-suffix-1] == d.b[bHi-suffix-1] {
+		suffix++
+	}
+
+	aHi -= suffix
+	bHi -= suffix
+
+	switch {
+	case aLo == aHi:
+		for _, line := range d.b[bLo:bHi] {
+			d.ops = append(d.ops, diffOp{kind: '+', line: line})
+		}
+	case bLo == bHi:
+		for _, line := range d.a[aLo:aHi] {
+			d.ops = append(d.ops, diffOp{kind: '-', line: line})
+		}
+	default:
+		// Both ranges are non-empty and differ at both ends, so the middle snake
+		// splits them into two smaller problems.
+		x, y, u, v := d.middleSnake(aLo, aHi, bLo, bHi)
+
+		d.compare(aLo, x, bLo, y)
+
+		for ; x < u; x, y = x+1, y+1 {
+			d.ops = append(d.ops, diffOp{kind: ' ', line: d.a[x]})
+		}
+
+		d.compare(u, aHi, v, bHi)
+	}
+
+	for _, line := range d.a[aHi : aHi+suffix] {
+		d.ops = append(d.ops, diffOp{kind: ' ', line: line})
+	}
+}
+
+// middleSnake returns the start (x, y) and end (u, v) of the middle snake of an
+// optimal path from (aLo, bLo) to (aHi, bHi), searching forwards and backwards
+// at the same time as described in "An O(ND) Difference Algorithm and Its Variations".
+func (d *lineDiff) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+	maxD := (n + m + 1) / 2
+
+	// forward[offset+k] is the furthest x reached on diagonal k = x - y from the start,
+	// and backward[offset+k] the furthest distance reached on diagonal k from the end.
+	offset := maxD + 1
+	forward := make([]int, 2*maxD+3)
+	backward := make([]int, 2*maxD+3)
+
+	for D := 0; D <= maxD; D++ {
+		for k := -D; k <= D; k += 2 {
+			var fx int
+			if k == -D || (k != D && forward[offset+k-1] < forward[offset+k+1]) {
+				fx = forward[offset+k+1]
+			} else {
+				fx = forward[offset+k-1] + 1
+			}
+
+			fy := fx - k
+			sx, sy := fx, fy
+
+			for fx < n && fy < m && d.a[aLo+fx] == d.b[bLo+fy] {
+				fx++
+				fy++
+			}
+
+			forward[offset+k] = fx
+
+			// The backward diagonal delta-k was reached in D-1 steps
+			if kb := delta - k; odd && kb >= -(D-1) && kb <= D-1 && fx+backward[offset+kb] >= n {
+				return aLo + sx, bLo + sy, aLo + fx, bLo + fy
+			}
+		}
+
+		for kb := -D; kb <= D; kb += 2 {
+			var bx int
+			if kb == -D || (kb != D && backward[offset+kb-1] < backward[offset+kb+1]) {
+				bx = backward[offset+kb+1]
+			} else {
+				bx = backward[offset+kb-1] + 1
+			}
+
+			by := bx - kb
+			sx, sy := bx, by
+
+			for bx < n && by < m && d.a[aHi-bx-1] == d.b[bHi-by-1] {
+				bx++
+				by++
+			}
+
+			backward[offset+kb] = bx
+
+			// The forward diagonal delta-kb was reached in D steps
+			if k := delta - kb; !odd && k >= -D && k <= D && bx+forward[offset+k] >= n {
+				return aHi - bx, bHi - by, aHi - sx, bHi - sy
+			}
+		}
+	}
+
+	// An optimal path has at most n+m differences, so a snake is always found above
+	return aLo, bLo, aLo, bLo
+}
+
 // ListStackscripts lists Stackscripts
 func (c *Client) ListStackscripts(ctx context.Context, opts *ListOptions) ([]Stackscript, error) {
 	return getPaginatedResults[Stackscript](ctx, c, "linode/stackscripts", opts)
@@ -125,10 +439,31 @@ func (c *Client) CreateStackscript(ctx context.Context, opts StackscriptCreateOp
 	return doPOSTRequest[Stackscript](ctx, c, "linode/stackscripts", opts)
 }
 
-// UpdateStackscript updates the StackScript with the specified id
+// UpdateStackscript updates the StackScript with the specified id.
+// If the API rejects the update because a public StackScript cannot be made private,
+// the returned error wraps ErrStackscriptPublic.
 func (c *Client) UpdateStackscript(ctx context.Context, scriptID int, opts StackscriptUpdateOptions) (*Stackscript, error) {
 	e := formatAPIPath("linode/stackscripts/%d", scriptID)
-	return doPUTRequest[Stackscript](ctx, c, e, opts)
+
+	result, err := doPUTRequest[Stackscript](ctx, c, e, opts)
+	if err != nil && isStackscriptPublicError(err) {
+		return nil, fmt.Errorf("%w: %w", ErrStackscriptPublic, err)
+	}
+
+	return result, err
+}
+
+// isStackscriptPublicError returns whether err is the API's rejection of
+// making a public StackScript private, which is reported against the is_public field.
+func isStackscriptPublicError(err error) bool {
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode() != http.StatusBadRequest {
+		return false
+	}
+
+	return slices.ContainsFunc(e.Reasons(), func(r APIErrorReason) bool {
+		return r.Field == "is_public" && strings.Contains(strings.ToLower(r.Reason), "cannot be made private")
+	})
 }
 
 // DeleteStackscript deletes the StackScript with the specified id
@@ -2,7 +2,11 @@ package linodego
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
 )
 
 // Ticket represents a support ticket object
@@ -19,7 +23,7 @@ type Ticket struct {
 	Summary     string        `json:"summary"`
 	Updated     *time.Time    `json:"-"`
 	UpdatedBy   string        `json:"updated_by"`
-	Closeable   bool          `json:"closeable"`
+	Closeable   bool          `json:"closable"`
 }
 
 // TicketEntity refers a ticket to a specific entity
@@ -40,6 +44,86 @@ const (
 	TicketOpen   TicketStatus = "open"
 )
 
+// TicketCreateOptions fields are those accepted by CreateTicket.
+// At most one of the entity IDs may be set to associate the ticket with that entity.
+type TicketCreateOptions struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+
+	DatabaseID     int    `json:"database_id,omitempty"`
+	DomainID       int    `json:"domain_id,omitempty"`
+	FirewallID     int    `json:"firewall_id,omitempty"`
+	LKEClusterID   int    `json:"lkecluster_id,omitempty"`
+	LinodeID       int    `json:"linode_id,omitempty"`
+	LongviewID     int    `json:"longviewclient_id,omitempty"`
+	NodeBalancerID int    `json:"nodebalancer_id,omitempty"`
+	VLAN           string `json:"vlan,omitempty"`
+	VolumeID       int    `json:"volume_id,omitempty"`
+
+	// Bucket and Region identify an Object Storage bucket the ticket relates to.
+	Bucket string `json:"bucket,omitempty"`
+	Region string `json:"region,omitempty"`
+}
+
+// TicketReply represents a reply to a support ticket
+type TicketReply struct {
+	ID          int        `json:"id"`
+	Created     *time.Time `json:"-"`
+	CreatedBy   string     `json:"created_by"`
+	Description string     `json:"description"`
+	FromLinode  bool       `json:"from_linode"`
+	GravatarID  string     `json:"gravatar_id"`
+}
+
+// TicketReplyCreateOptions fields are those accepted by CreateTicketReply
+type TicketReplyCreateOptions struct {
+	Description string `json:"description"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *Ticket) UnmarshalJSON(b []byte) error {
+	type Mask Ticket
+
+	p := struct {
+		*Mask
+		Closed  *parseabletime.ParseableTime `json:"closed"`
+		Opened  *parseabletime.ParseableTime `json:"opened"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Closed = (*time.Time)(p.Closed)
+	i.Opened = (*time.Time)(p.Opened)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *TicketReply) UnmarshalJSON(b []byte) error {
+	type Mask TicketReply
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+
+	return nil
+}
+
 // ListTickets returns a collection of Support Tickets on the Account. Support Tickets
 // can be both tickets opened with Linode for support, as well as tickets generated by
 // Linode regarding the Account. This collection includes all Support Tickets generated
@@ -53,3 +137,35 @@ func (c *Client) GetTicket(ctx context.Context, ticketID int) (*Ticket, error) {
 	e := formatAPIPath("support/tickets/%d", ticketID)
 	return doGETRequest[Ticket](ctx, c, e)
 }
+
+// CreateTicket opens a Support Ticket on the Account
+func (c *Client) CreateTicket(ctx context.Context, opts TicketCreateOptions) (*Ticket, error) {
+	return doPOSTRequest[Ticket](ctx, c, "support/tickets", opts)
+}
+
+// CloseTicket closes the Support Ticket with the specified ID.
+// Only tickets that are Closeable can be closed.
+func (c *Client) CloseTicket(ctx context.Context, ticketID int) error {
+	e := formatAPIPath("support/tickets/%d/close", ticketID)
+	return doPOSTRequestNoRequestResponseBody(ctx, c, e)
+}
+
+// ListTicketReplies lists the replies to the Support Ticket with the specified ID
+func (c *Client) ListTicketReplies(ctx context.Context, ticketID int, opts *ListOptions) ([]TicketReply, error) {
+	return getPaginatedResults[TicketReply](ctx, c, formatAPIPath("support/tickets/%d/replies", ticketID), opts)
+}
+
+// CreateTicketReply adds a reply to the Support Ticket with the specified ID
+func (c *Client) CreateTicketReply(ctx context.Context, ticketID int, opts TicketReplyCreateOptions) (*TicketReply, error) {
+	e := formatAPIPath("support/tickets/%d/replies", ticketID)
+	return doPOSTRequest[TicketReply](ctx, c, e, opts)
+}
+
+// UploadTicketAttachment attaches a file to the Support Ticket with the specified ID.
+// The attachment is sent as multipart/form-data under the given filename.
+func (c *Client) UploadTicketAttachment(ctx context.Context, ticketID int, filename string, attachment io.Reader) error {
+	e := formatAPIPath("support/tickets/%d/attachments", ticketID)
+	_, err := doMultipartPOSTRequest[any](ctx, c, e, nil, "file", filename, attachment)
+
+	return err
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
 )
 
 // Tag represents a Tag object
@@ -30,6 +32,44 @@ type SortedObjects struct {
 	*/
 }
 
+// TaggedObjectType represents the type of an object that can be tagged
+type TaggedObjectType string
+
+// TaggedObjectType constants reflect the types of objects that can be tagged
+const (
+	TaggedObjectLinode       TaggedObjectType = "linode"
+	TaggedObjectLKECluster   TaggedObjectType = "lke_cluster"
+	TaggedObjectDomain       TaggedObjectType = "domain"
+	TaggedObjectVolume       TaggedObjectType = "volume"
+	TaggedObjectNodeBalancer TaggedObjectType = "nodebalancer"
+)
+
+// TagTarget identifies an object to apply a Tag to in ApplyTag
+type TagTarget struct {
+	Type TaggedObjectType
+	ID   int
+}
+
+// TagTargetError is a TagTarget that could not be tagged, along with the reason
+type TagTargetError struct {
+	Target TagTarget
+	Err    error
+}
+
+func (e TagTargetError) Error() string {
+	return fmt.Sprintf("failed to tag %s %d: %s", e.Target.Type, e.Target.ID, e.Err)
+}
+
+func (e TagTargetError) Unwrap() error {
+	return e.Err
+}
+
+// ApplyTagResult lists the targets that were and were not tagged by ApplyTag
+type ApplyTagResult struct {
+	Succeeded []TagTarget
+	Failed    []TagTargetError
+}
+
 // TaggedObjectList are a list of TaggedObjects, as returning by ListTaggedObjects
 type TaggedObjectList []TaggedObject
 
@@ -159,3 +199,115 @@ func (c *Client) DeleteTag(ctx context.Context, label string) error {
 	e := formatAPIPath("tags/%s", label)
 	return doDELETERequest(ctx, c, e)
 }
+
+// ApplyTag adds the Tag with the given label to each of the given targets,
+// preserving any existing tags. Targets are processed grouped by type, and targets
+// which already have the tag are not updated.
+// The returned ApplyTagResult lists which targets succeeded and which failed.
+// If any target failed, the returned error joins each of the failures.
+func (c *Client) ApplyTag(ctx context.Context, label string, targets []TagTarget) (*ApplyTagResult, error) {
+	sorted := slices.Clone(targets)
+	slices.SortStableFunc(sorted, func(a, b TagTarget) int {
+		if a.Type < b.Type {
+			return -1
+		} else if a.Type > b.Type {
+			return 1
+		}
+		return 0
+	})
+
+	result := &ApplyTagResult{}
+	errs := make([]error, 0)
+
+	for _, target := range sorted {
+		if err := c.applyTagToTarget(ctx, label, target); err != nil {
+			targetErr := TagTargetError{Target: target, Err: err}
+			result.Failed = append(result.Failed, targetErr)
+			errs = append(errs, targetErr)
+
+			continue
+		}
+
+		result.Succeeded = append(result.Succeeded, target)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// applyTagToTarget adds the given label to the tags of a single TagTarget
+// nolint:gocognit
+func (c *Client) applyTagToTarget(ctx context.Context, label string, target TagTarget) error {
+	// withTag returns the given tags with label appended, or nil if
+	// label is already present.
+	withTag := func(tags []string) *[]string {
+		if slices.Contains(tags, label) {
+			return nil
+		}
+
+		result := append(slices.Clone(tags), label)
+		return &result
+	}
+
+	switch target.Type {
+	case TaggedObjectLinode:
+		instance, err := c.GetInstance(ctx, target.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags := withTag(instance.Tags); tags != nil {
+			_, err = c.UpdateInstance(ctx, target.ID, InstanceUpdateOptions{Tags: tags})
+		}
+
+		return err
+	case TaggedObjectLKECluster:
+		cluster, err := c.GetLKECluster(ctx, target.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags := withTag(cluster.Tags); tags != nil {
+			_, err = c.UpdateLKECluster(ctx, target.ID, LKEClusterUpdateOptions{Tags: tags})
+		}
+
+		return err
+	case TaggedObjectDomain:
+		domain, err := c.GetDomain(ctx, target.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags := withTag(domain.Tags); tags != nil {
+			// Domain updates do not omit empty fields, so start from the current Domain
+			opts := domain.GetUpdateOptions()
+			opts.Tags = *tags
+			_, err = c.UpdateDomain(ctx, target.ID, opts)
+		}
+
+		return err
+	case TaggedObjectVolume:
+		volume, err := c.GetVolume(ctx, target.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags := withTag(volume.Tags); tags != nil {
+			_, err = c.UpdateVolume(ctx, target.ID, VolumeUpdateOptions{Tags: tags})
+		}
+
+		return err
+	case TaggedObjectNodeBalancer:
+		nodebalancer, err := c.GetNodeBalancer(ctx, target.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags := withTag(nodebalancer.Tags); tags != nil {
+			_, err = c.UpdateNodeBalancer(ctx, target.ID, NodeBalancerUpdateOptions{Tags: tags})
+		}
+
+		return err
+	default:
+		return fmt.Errorf("unsupported tag target type: %q", target.Type)
+	}
+}
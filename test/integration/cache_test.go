@@ -68,8 +68,8 @@ func TestCache_RegionList(t *testing.T) {
 	validateResult(client.ListRegions(context.Background(), nil))
 
 	// Validate request count
-	if totalRequests != 4 {
-		t.Fatalf("expected 4 requests, got %d", totalRequests)
+	if totalRequests != 5 {
+		t.Fatalf("expected 5 requests, got %d", totalRequests)
 	}
 }
 
@@ -143,10 +143,14 @@ func TestLKECluster_Nodes_Recycle(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = client.RecycleLKEClusterNodes(context.TODO(), cluster.ID)
+	nodeIDs, err := client.RecycleLKEClusterNodes(context.TODO(), cluster.ID)
 	if err != nil {
 		t.Errorf("failed to recycle LKE cluster: %s", err)
 	}
+
+	if len(nodeIDs) == 0 {
+		t.Errorf("expected the recycled node IDs, got %v", nodeIDs)
+	}
 }
 
 func TestLKECluster_APIEndpoints_List(t *testing.T) {
@@ -187,10 +187,14 @@ func TestLKENodePool_Recycle(t *testing.T) {
 		t.Errorf("Error getting LKENodePool, expected struct, got %v and error %v", i, err)
 	}
 
-	err = client.RecycleLKENodePool(context.Background(), lkeCluster.ID, pool.ID)
+	nodeIDs, err := client.RecycleLKENodePool(context.Background(), lkeCluster.ID, pool.ID)
 	if err != nil {
 		t.Errorf("failed to recycle node pool: %s", err)
 	}
+
+	if diff := cmp.Diff(i.NodeIDs(), nodeIDs); diff != "" {
+		t.Errorf("unexpected recycled node IDs:\n%s", diff)
+	}
 }
 
 func TestLKENodePool_Update(t *testing.T) {
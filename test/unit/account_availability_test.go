@@ -73,6 +73,19 @@ func TestAccountAvailability_Get(t *testing.T) {
 	assert.ElementsMatch(t, expectedUnavailable, availability.Unavailable, "Unavailable resources do not match the expected list")
 }
 
+func TestAccountAvailability_CheckAvailable(t *testing.T) {
+	availability := linodego.AccountAvailability{
+		Region:      "us-east",
+		Available:   []string{"Linodes", "NodeBalancers"},
+		Unavailable: []string{"Kubernetes", "Block Storage"},
+	}
+
+	assert.NoError(t, availability.CheckAvailable("Linodes", "NodeBalancers"))
+
+	err := availability.CheckAvailable("Linodes", "Kubernetes", "Block Storage")
+	assert.EqualError(t, err, "region us-east does not offer the following to this account: Kubernetes, Block Storage")
+}
+
 // Helper function to compare slices in assertion
 func equalSlices(a, b []string) bool {
 	if len(a) != len(b) {
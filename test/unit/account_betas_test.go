@@ -73,3 +73,39 @@ func TestAccountBetaProgram_Join(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAccountBetaProgram_EnsureJoined(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("account_beta_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("account/betas/example_open", fixtureData)
+
+	betaProgram, err := base.Client.EnsureBetaProgramJoined(context.Background(), "example_open")
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-09-11 00:00:00 +0000 UTC", betaProgram.Enrolled.String())
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"account/betas"])
+}
+
+func TestAccountBetaProgram_EnsureJoinedNotEnrolled(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("account_beta_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/betas/example_open"),
+		httpmock.NewJsonResponderOrPanic(404, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Not found"}},
+		}))
+	base.MockPost("account/betas", fixtureData)
+
+	betaProgram, err := base.Client.EnsureBetaProgramJoined(context.Background(), "example_open")
+	assert.NoError(t, err)
+	assert.Equal(t, "example_open", betaProgram.ID)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"account/betas"])
+}
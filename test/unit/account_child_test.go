@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -105,3 +106,12 @@ func TestAccountChild_createToken(t *testing.T) {
 	assert.Equal(t, "*", token.Scopes)
 	assert.Equal(t, "abcdefghijklmnop", token.Token)
 }
+
+func TestAccountChild_tokenExpiresWithin(t *testing.T) {
+	expiry := time.Now().Add(10 * time.Minute)
+	token := linodego.ChildAccountToken{Expiry: &expiry}
+
+	assert.True(t, token.ExpiresWithin(15*time.Minute))
+	assert.False(t, token.ExpiresWithin(5*time.Minute))
+	assert.False(t, linodego.ChildAccountToken{}.ExpiresWithin(time.Hour))
+}
@@ -2,8 +2,11 @@ package unit
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -75,3 +78,75 @@ func TestAccountEvents_Get(t *testing.T) {
 	assert.Equal(t, linodego.EntityType("linode"), event.SecondaryEntity.Type)
 	assert.Equal(t, "/v4/linode/instances/1234", event.SecondaryEntity.URL)
 }
+
+func TestAccountEvents_WaitForResourceFreeWithOptions(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	busy := true
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"account/events",
+		func(req *http.Request) (*http.Response, error) {
+			data := []any{
+				map[string]any{"id": 1, "action": "linode_boot", "status": "finished"},
+			}
+			if busy {
+				data = append(data, map[string]any{"id": 2, "action": "disk_resize", "status": "started"})
+				busy = false
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"page": 1, "pages": 1, "results": len(data), "data": data})
+		})
+
+	pending, err := base.Client.WaitForResourceFreeWithOptions(
+		context.Background(), linodego.EntityLinode, 123,
+		linodego.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second},
+	)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestAccountEvents_WaitForResourceFreeWithOptionsTimeout(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("account/events", map[string]any{
+		"page": 1, "pages": 1, "results": 1,
+		"data": []any{
+			map[string]any{"id": 2, "action": "disk_resize", "status": "started"},
+		},
+	})
+
+	pending, err := base.Client.WaitForResourceFreeWithOptions(
+		context.Background(), linodego.EntityLinode, 123,
+		linodego.WaitOptions{PollInterval: time.Millisecond, Timeout: 50 * time.Millisecond},
+	)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, 2, pending[0].ID)
+}
+
+func TestAccountEvents_MarkRead(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockPost("account/events/400/read", map[string]any{})
+
+	assert.NoError(t, base.Client.MarkEventReadByID(context.Background(), 400))
+	assert.NoError(t, base.Client.MarkEventRead(context.Background(), &linodego.Event{ID: 400}))
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"account/events/400/read"])
+}
+
+func TestAccountEvents_MarkSeen(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockPost("account/events/400/seen", map[string]any{})
+
+	assert.NoError(t, base.Client.MarkEventsSeenUpToID(context.Background(), 400))
+	assert.NoError(t, base.Client.MarkEventsSeen(context.Background(), &linodego.Event{ID: 400}))
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"account/events/400/seen"])
+}
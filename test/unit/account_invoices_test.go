@@ -2,6 +2,7 @@ package unit
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,6 +33,7 @@ func TestAccountInvoices_List(t *testing.T) {
 	assert.Equal(t, "PA STATE TAX", invoice.TaxSummary[0].Name)
 	assert.Equal(t, float32(12.25), invoice.TaxSummary[0].Tax)
 	assert.Equal(t, float32(132.5), invoice.Total)
+	assert.Equal(t, int64(13250), invoice.TotalCents)
 }
 
 func TestAccountInvoices_Get(t *testing.T) {
@@ -84,4 +86,49 @@ func TestAccountInvoiceItems_List(t *testing.T) {
 	assert.Equal(t, float32(21.45), invoiceItem.Total)
 	assert.Equal(t, "hourly", invoiceItem.Type)
 	assert.Equal(t, float32(5.05), invoiceItem.UnitPrice)
+	assert.Equal(t, int64(2020), invoiceItem.AmountCents)
+	assert.Equal(t, int64(2145), invoiceItem.TotalCents)
+}
+
+func TestAccountInvoices_ExactAmounts(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("account/invoices/123", map[string]any{
+		"id":       123,
+		"subtotal": 123456.78,
+		"tax":      -0.125,
+		"total":    123456.66,
+	})
+
+	invoice, err := base.Client.GetInvoice(context.Background(), 123)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(12345678), invoice.SubtotalCents)
+	assert.Equal(t, int64(-13), invoice.TaxCents)
+	assert.Equal(t, int64(12345666), invoice.TotalCents)
+	assert.Equal(t, float32(123456.78), invoice.Subtotal)
+}
+
+func TestAccountInvoices_ExponentAndShortAmounts(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("account/invoices/123", json.RawMessage(`{
+		"id": 123,
+		"subtotal": 1e3,
+		"tax": 5e-03,
+		"total": 1.5
+	}`))
+
+	invoice, err := base.Client.GetInvoice(context.Background(), 123)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(100000), invoice.SubtotalCents)
+	assert.Equal(t, int64(1), invoice.TaxCents)
+	assert.Equal(t, int64(150), invoice.TotalCents)
+	assert.Equal(t, float32(1000), invoice.Subtotal)
+	assert.Equal(t, float32(0.005), invoice.Tax)
 }
@@ -3,7 +3,11 @@ package unit
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
 
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
@@ -54,3 +58,30 @@ func TestAccountLogin_Get(t *testing.T) {
 	assert.Equal(t, "successful", login.Status, "Expected login status to be 'successful'")
 	assert.Equal(t, "example_user", login.Username, "Expected login username to be 'example_user'")
 }
+
+func TestAccountLogins_ListBetween(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("account_logins_list")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	var filter string
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/logins"),
+		func(req *http.Request) (*http.Response, error) {
+			filter = req.Header.Get("X-Filter")
+			return httpmock.NewJsonResponse(http.StatusOK, fixtureData)
+		})
+
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	end := start.AddDate(0, 0, 1)
+
+	logins, err := base.Client.ListLoginsBetween(context.Background(), start, end)
+	assert.NoError(t, err)
+	assert.Len(t, logins, 1)
+	assert.JSONEq(t,
+		`{"+and":[{"datetime":{"+gte":"2018-01-01T05:00:00"}},{"datetime":{"+lt":"2018-01-02T05:00:00"}}],"+order_by":"datetime","+order":"asc"}`,
+		filter)
+}
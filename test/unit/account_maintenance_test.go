@@ -2,8 +2,11 @@ package unit
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,3 +35,30 @@ func TestAccountMaintenances_List(t *testing.T) {
 	assert.Equal(t, "started", maintenance.Status)
 	assert.Equal(t, "reboot", maintenance.Type)
 }
+
+func TestAccountMaintenances_ListBetween(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("account_maintenance_list")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	var filter string
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/maintenance"),
+		func(req *http.Request) (*http.Response, error) {
+			filter = req.Header.Get("X-Filter")
+			return httpmock.NewJsonResponse(http.StatusOK, fixtureData)
+		})
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+
+	maintenances, err := base.Client.ListMaintenancesBetween(context.Background(), start, end)
+	assert.NoError(t, err)
+	assert.Len(t, maintenances, 1)
+	assert.JSONEq(t,
+		`{"+and":[{"when":{"+gte":"2024-01-01T00:00:00"}},{"when":{"+lt":"2024-01-08T00:00:00"}}],"+order_by":"when","+order":"asc"}`,
+		filter)
+}
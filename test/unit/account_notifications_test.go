@@ -36,3 +36,18 @@ func TestAccountNotifications_List(t *testing.T) {
 	assert.Equal(t, "ticket", notification.Entity.Type, "Expected entity type to be 'ticket'.")
 	assert.Equal(t, "/support/tickets/3456", notification.Entity.URL, "Expected entity URL to be '/support/tickets/3456'")
 }
+
+func TestAccountNotifications_IsMaintenance(t *testing.T) {
+	tests := map[linodego.NotificationType]bool{
+		linodego.NotificationMaintenance:        true,
+		linodego.NotificationMigrationScheduled: true,
+		linodego.NotificationRebootScheduled:    true,
+		linodego.NotificationPaymentDue:         false,
+		linodego.NotificationType("promotion"):  false,
+	}
+
+	for notificationType, expected := range tests {
+		n := linodego.Notification{Type: notificationType}
+		assert.Equal(t, expected, n.IsMaintenance(), notificationType)
+	}
+}
@@ -24,7 +24,7 @@ func TestAccountPaymentMethods_Get(t *testing.T) {
 
 	assert.Equal(t, 123, pm.ID)
 	assert.Equal(t, true, pm.IsDefault)
-	assert.Equal(t, "credit_card", pm.Type)
+	assert.Equal(t, string(linodego.PaymentMethodTypeCreditCard), pm.Type)
 	assert.Equal(t, linodego.PaymentMethodDataCreditCard{
 		CardType: "Discover",
 		Expiry:   "06/2022",
@@ -92,12 +92,15 @@ func TestAccountPaymentMethods_Delete(t *testing.T) {
 }
 
 func TestAccountPaymentMethods_SetDefault(t *testing.T) {
-	client := createMockClient(t)
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
 
-	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "account/payment-methods/123"),
-		httpmock.NewStringResponder(200, "{}"))
+	base.MockPost("account/payment-methods/123/make-default", "{}")
 
-	if err := client.SetDefaultPaymentMethod(context.Background(), 123); err != nil {
+	if err := base.Client.SetDefaultPaymentMethod(context.Background(), 123); err != nil {
 		t.Fatal(err)
 	}
+
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"account/payment-methods/123/make-default"])
 }
@@ -110,3 +110,12 @@ func TestAccountServiceTransfer_Cancel(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAccountServiceTransfer_Expired(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	assert.True(t, linodego.AccountServiceTransfer{Expiry: &past}.Expired())
+	assert.False(t, linodego.AccountServiceTransfer{Expiry: &future}.Expired())
+	assert.False(t, linodego.AccountServiceTransfer{}.Expired())
+}
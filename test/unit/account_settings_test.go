@@ -56,3 +56,19 @@ func TestAccountSettings_Update(t *testing.T) {
 	assert.True(t, accountSettings.BackupsEnabled, "Expected 'backups_enabled' to be true")
 	assert.Equal(t, "active", *accountSettings.ObjectStorage, "Expected 'object_storage' to be 'active'")
 }
+
+func TestAccountSettings_GetUpdateOptions(t *testing.T) {
+	settings := linodego.AccountSettings{
+		BackupsEnabled: false,
+		NetworkHelper:  true,
+	}
+
+	opts := settings.GetUpdateOptions()
+	assert.False(t, *opts.BackupsEnabled)
+	assert.True(t, *opts.NetworkHelper)
+	assert.Nil(t, opts.LongviewSubscription)
+
+	// Modifying the options must not affect the source settings
+	*opts.BackupsEnabled = true
+	assert.False(t, settings.BackupsEnabled)
+}
@@ -2,8 +2,11 @@ package unit
 
 import (
 	"context"
+	"io"
+	"net/http"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -93,3 +96,28 @@ func TestAccount_Update(t *testing.T) {
 	assert.Equal(t, "Cambridge", accountInfo.City)
 	assert.Equal(t, "MA", accountInfo.State)
 }
+
+func TestAccount_UpdateNullFields(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	var body string
+
+	httpmock.RegisterResponder("PUT", base.BaseURL+"account",
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"company": ""})
+		})
+
+	// Company is cleared, TaxID is sent as null, Address2 is omitted and City is sent as is
+	_, err := base.Client.UpdateAccount(context.Background(), linodego.AccountUpdateOptions{
+		City:    "Cambridge",
+		Company: linodego.NullOf(""),
+		TaxID:   linodego.ExplicitNull[string](),
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"city": "Cambridge", "company": "", "tax_id": null}`, body)
+}
@@ -143,3 +143,23 @@ func TestAccountGrants_Update(t *testing.T) {
 	assert.Equal(t, true, grants.Global.ChildAccountAccess)
 	assert.Equal(t, true, grants.Global.LongviewSubscription)
 }
+
+func TestAccountUserGrants_GetUpdateOptions(t *testing.T) {
+	grants := linodego.UserGrants{
+		Linode: []linodego.GrantedEntity{
+			{ID: 123, Label: "example-entity", Permissions: linodego.AccessLevelReadWrite},
+			{ID: 456, Label: "no-access"},
+		},
+		Global: linodego.GlobalUserGrants{AddLinodes: true},
+	}
+
+	opts := grants.GetUpdateOptions()
+
+	assert.Len(t, opts.Linode, 2)
+	assert.Equal(t, 123, opts.Linode[0].ID)
+	assert.Equal(t, linodego.AccessLevelReadWrite, *opts.Linode[0].Permissions)
+	assert.Equal(t, 456, opts.Linode[1].ID)
+	assert.Nil(t, opts.Linode[1].Permissions)
+	assert.Nil(t, opts.Domain)
+	assert.True(t, opts.Global.AddLinodes)
+}
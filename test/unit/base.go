@@ -1,7 +1,10 @@
 package unit
 
 import (
+	"fmt"
+	"maps"
 	"net/http"
+	"sync/atomic"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -57,3 +60,35 @@ func (c *ClientBaseCase) MockDelete(path string, response interface{}) {
 	fullURL := c.BaseURL + path
 	httpmock.RegisterResponder("DELETE", fullURL, httpmock.NewJsonResponderOrPanic(http.StatusOK, response))
 }
+
+// MockEventFeed mocks a request that triggers an asynchronous event, as used by the ...AndWait helpers.
+// account/events returns no events until the request to method and path has been handled by responder,
+// after which it returns event with a "started" status, and account/events/<id> returns it as "finished".
+// The returned function reports whether the triggering request has been made.
+func (c *ClientBaseCase) MockEventFeed(event map[string]any, method, path string, responder httpmock.Responder) func() bool {
+	var triggered atomic.Bool
+
+	started := maps.Clone(event)
+	started["status"] = "started"
+
+	httpmock.RegisterResponder("GET", c.BaseURL+"account/events",
+		func(req *http.Request) (*http.Response, error) {
+			data := []any{}
+			if triggered.Load() {
+				data = append(data, started)
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"page": 1, "pages": 1, "results": len(data), "data": data})
+		})
+
+	httpmock.RegisterResponder(method, c.BaseURL+path,
+		func(req *http.Request) (*http.Response, error) {
+			triggered.Store(true)
+			return responder(req)
+		})
+
+	c.MockGet(fmt.Sprintf("account/events/%v", event["id"]), map[string]any{
+		"id": event["id"], "action": event["action"], "status": "finished",
+	})
+
+	return triggered.Load
+}
@@ -1,9 +1,15 @@
 package unit
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/net/http2"
 
@@ -72,3 +78,443 @@ func TestClient_GoAwayRetry(t *testing.T) {
 		t.Fatalf("retry checks did not finish")
 	}
 }
+
+func TestClient_WithHeaderRequestOption(t *testing.T) {
+	client := createMockClient(t)
+
+	var headers []string
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/profile"),
+		func(request *http.Request) (*http.Response, error) {
+			headers = append(headers, request.Header.Get("X-Filter-Beta"))
+			return httpmock.NewJsonResponse(200, map[string]any{})
+		})
+
+	ctx := linodego.WithRequestOptions(context.Background(), linodego.WithHeader("X-Filter-Beta", "1"))
+
+	if _, err := client.GetProfile(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetProfile(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(headers) != 2 || headers[0] != "1" || headers[1] != "" {
+		t.Fatalf("unexpected header values: %v", headers)
+	}
+}
+
+func TestClient_WithTimeout(t *testing.T) {
+	client := createMockClient(t)
+
+	var deadlines []time.Time
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/profile"),
+		func(request *http.Request) (*http.Response, error) {
+			deadline, _ := request.Context().Deadline()
+			deadlines = append(deadlines, deadline)
+			return httpmock.NewJsonResponse(200, map[string]any{})
+		})
+
+	start := time.Now()
+
+	ctx := linodego.WithRequestOptions(context.Background(), linodego.WithTimeout(time.Minute))
+	if _, err := client.GetProfile(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The parent context's earlier deadline takes precedence
+	parent, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	parentDeadline, _ := parent.Deadline()
+
+	ctx = linodego.WithRequestOptions(parent, linodego.WithTimeout(time.Hour))
+	if _, err := client.GetProfile(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetProfile(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deadlines) != 3 {
+		t.Fatalf("unexpected number of requests: %d", len(deadlines))
+	}
+
+	if deadlines[0].Before(start.Add(time.Minute)) || deadlines[0].After(time.Now().Add(time.Minute)) {
+		t.Fatalf("unexpected deadline for timeout option: %v", deadlines[0])
+	}
+
+	if !deadlines[1].Equal(parentDeadline) {
+		t.Fatalf("expected parent deadline %v, got %v", parentDeadline, deadlines[1])
+	}
+
+	if !deadlines[2].IsZero() {
+		t.Fatalf("expected no deadline, got %v", deadlines[2])
+	}
+}
+
+func TestClient_WithTimeoutReleasedOnCompletion(t *testing.T) {
+	client := createMockClient(t)
+
+	var requestCtx context.Context
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/profile"),
+		func(request *http.Request) (*http.Response, error) {
+			requestCtx = request.Context()
+			return httpmock.NewJsonResponse(200, map[string]any{})
+		})
+
+	ctx := linodego.WithRequestOptions(context.Background(), linodego.WithTimeout(time.Hour), linodego.WithTimeout(time.Minute))
+	if _, err := client.GetProfile(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The timeout context is cancelled when the request completes rather than when it expires
+	if !errors.Is(requestCtx.Err(), context.Canceled) {
+		t.Fatalf("expected request context to be released, got %v", requestCtx.Err())
+	}
+}
+
+func TestClient_SetEndpointCache(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/regions"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"page": 1, "pages": 1, "results": 1,
+			"data": []map[string]any{{"id": "us-east"}},
+		}))
+
+	regionCalls := func() int {
+		return httpmock.GetCallCountInfo()["GET =~"+mockRequestURL(t, "/regions").String()]
+	}
+
+	listRegions := func(ctx context.Context, count int) {
+		for range count {
+			if _, err := client.ListRegions(ctx, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// Regions are cached by default
+	listRegions(context.Background(), 2)
+	if calls := regionCalls(); calls != 1 {
+		t.Fatalf("expected 1 request, got %d", calls)
+	}
+
+	// A configured TTL takes precedence over the default expiration
+	client.SetEndpointCache(time.Nanosecond, "regions")
+	listRegions(context.Background(), 2)
+	if calls := regionCalls(); calls != 3 {
+		t.Fatalf("expected 3 requests after setting a short TTL, got %d", calls)
+	}
+
+	// Disabling an endpoint skips the cache entirely
+	client.SetEndpointCache(0, "regions")
+	listRegions(context.Background(), 2)
+	if calls := regionCalls(); calls != 5 {
+		t.Fatalf("expected 5 requests with caching disabled, got %d", calls)
+	}
+
+	// The default endpoints include regions
+	client.SetEndpointCache(time.Hour)
+	listRegions(context.Background(), 2)
+	if calls := regionCalls(); calls != 6 {
+		t.Fatalf("expected 6 requests with the default endpoints cached, got %d", calls)
+	}
+}
+
+func TestClient_InvalidateCacheEndpoint(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/regions"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"page": 1, "pages": 1, "results": 1,
+			"data": []map[string]any{{"id": "us-east"}},
+		}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/linode/types"),
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"page": 1, "pages": 1, "results": 1,
+			"data": []map[string]any{{"id": "g6-standard-1"}},
+		}))
+
+	list := func() {
+		if _, err := client.ListRegions(context.Background(), nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := client.ListTypes(context.Background(), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	calls := func(endpoint string) int {
+		return httpmock.GetCallCountInfo()["GET =~"+mockRequestURL(t, endpoint).String()]
+	}
+
+	list()
+	list()
+
+	if err := client.InvalidateCacheEndpoint("/regions"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the invalidated endpoint should be requested again
+	list()
+
+	if regions, types := calls("/regions"), calls("/linode/types"); regions != 2 || types != 1 {
+		t.Fatalf("expected 2 region and 1 type requests, got %d and %d", regions, types)
+	}
+}
+
+func TestClient_CacheKeyIncludesRequestHeaders(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/regions"),
+		func(request *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]any{
+				"page": 1, "pages": 1, "results": 1,
+				"data": []map[string]any{{"id": request.Header.Get("X-Test")}},
+			})
+		})
+
+	list := func(ctx context.Context) string {
+		regions, err := client.ListRegions(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return regions[0].ID
+	}
+
+	withHeader := func(value string) context.Context {
+		return linodego.WithRequestOptions(context.Background(), linodego.WithHeader("X-Test", value))
+	}
+
+	if id := list(withHeader("a")); id != "a" {
+		t.Fatalf("unexpected region: %q", id)
+	}
+
+	// A different header value must not be served the cached response
+	if id := list(withHeader("b")); id != "b" {
+		t.Fatalf("expected response for the new header, got %q", id)
+	}
+
+	if id := list(withHeader("a")); id != "a" {
+		t.Fatalf("expected cached response for the original header, got %q", id)
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 2 {
+		t.Fatalf("expected 2 requests, got %d", calls)
+	}
+}
+
+func TestClient_SetRequestMetricsHook(t *testing.T) {
+	client := createMockClient(t)
+
+	var metrics []linodego.RequestMetrics
+
+	client.SetRequestMetricsHook(func(info linodego.RequestMetrics) {
+		metrics = append(metrics, info)
+	})
+
+	step := 0
+
+	httpmock.RegisterRegexpResponder("PUT",
+		mockRequestURL(t, "/profile"), func(request *http.Request) (*http.Response, error) {
+			if step == 0 {
+				step++
+				return nil, http2.GoAwayError{}
+			}
+
+			step++
+			return httpmock.NewJsonResponse(200, nil)
+		})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/profile"),
+		httpmock.NewJsonResponderOrPanic(404, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Not found"}},
+		}))
+
+	if _, err := client.UpdateProfile(context.Background(), linodego.ProfileUpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetProfile(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+
+	if metrics[0].Method != http.MethodPut || metrics[0].Endpoint != "/v4/profile" ||
+		metrics[0].StatusCode != 200 || metrics[0].Retries != 1 || metrics[0].Duration <= 0 {
+		t.Fatalf("unexpected metrics: %+v", metrics[0])
+	}
+
+	if metrics[1].Method != http.MethodGet || metrics[1].StatusCode != 404 || metrics[1].Retries != 0 {
+		t.Fatalf("unexpected metrics: %+v", metrics[1])
+	}
+
+	client.SetRequestMetricsHook(nil)
+
+	if _, err := client.UpdateProfile(context.Background(), linodego.ProfileUpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(metrics) != 2 {
+		t.Fatal("expected hook to be removed")
+	}
+}
+
+func TestClient_WithIdempotencyKey(t *testing.T) {
+	client := createMockClient(t)
+
+	var keys []string
+
+	step := 0
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "/volumes"),
+		func(request *http.Request) (*http.Response, error) {
+			keys = append(keys, request.Header.Get(linodego.IdempotencyKeyHeaderName))
+
+			if step == 0 {
+				step++
+				return nil, http2.GoAwayError{}
+			}
+
+			return httpmock.NewJsonResponse(200, map[string]any{})
+		})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "/profile"),
+		func(request *http.Request) (*http.Response, error) {
+			keys = append(keys, request.Header.Get(linodego.IdempotencyKeyHeaderName))
+			return httpmock.NewJsonResponse(200, map[string]any{})
+		})
+
+	ctx := linodego.WithRequestOptions(context.Background(), linodego.WithIdempotencyKey("abc123"))
+
+	if _, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{Label: "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetProfile(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The key should be reused across retries and not sent on GET requests
+	if len(keys) != 3 || keys[0] != "abc123" || keys[1] != "abc123" || keys[2] != "" {
+		t.Fatalf("unexpected idempotency keys: %v", keys)
+	}
+}
+
+func TestClient_SetStructuredLogger(t *testing.T) {
+	client := createMockClient(t)
+
+	var buf bytes.Buffer
+
+	client.SetStructuredLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	client.SetLogBodies(linodego.RedactSensitiveFields)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances"),
+		func(request *http.Request) (*http.Response, error) {
+			resp, err := httpmock.NewJsonResponse(200, map[string]any{"id": 123, "label": "web"})
+			resp.Header.Set("X-Linode-Request-ID", "req-123")
+			return resp, err
+		})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/456"),
+		httpmock.NewJsonResponderOrPanic(404, linodego.APIError{
+			Errors: []linodego.APIErrorReason{{Reason: "Not found"}},
+		}))
+
+	if _, err := client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{
+		Region:   "us-east",
+		Type:     "g6-standard-1",
+		Image:    "linode/debian12",
+		RootPass: "hunter2",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetInstance(context.Background(), 456); err == nil {
+		t.Fatal("expected error")
+	}
+
+	var entries []map[string]any
+
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var entry map[string]any
+		if err := decoder.Decode(&entry); err != nil {
+			t.Fatal(err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(entries) != 4 {
+		t.Fatalf("expected 4 log entries, got %d", len(entries))
+	}
+
+	created := entries[0]
+	if created["level"] != "INFO" || created["method"] != http.MethodPost || created["path"] != "/v4/linode/instances" ||
+		created["status"] != float64(200) || created["request_id"] != "req-123" || created["duration"] == nil {
+		t.Fatalf("unexpected log entry: %v", created)
+	}
+
+	if _, ok := created["request_body"]; ok {
+		t.Fatalf("expected bodies to be logged separately: %v", created)
+	}
+
+	bodies := entries[1]
+	if bodies["level"] != "DEBUG" || bodies["method"] != http.MethodPost || bodies["request_id"] != "req-123" {
+		t.Fatalf("unexpected log entry: %v", bodies)
+	}
+
+	requestBody, _ := bodies["request_body"].(string)
+	if strings.Contains(requestBody, "hunter2") || !strings.Contains(requestBody, `"root_pass":"[REDACTED]"`) {
+		t.Fatalf("expected root_pass to be redacted, got %s", requestBody)
+	}
+
+	if bodies["response_body"] != `{"id":123,"label":"web"}` {
+		t.Fatalf("unexpected response body: %v", bodies["response_body"])
+	}
+
+	if notFound := entries[2]; notFound["level"] != "WARN" || notFound["status"] != float64(404) {
+		t.Fatalf("unexpected log entry: %v", notFound)
+	}
+
+	if notFoundBodies := entries[3]; notFoundBodies["level"] != "DEBUG" || notFoundBodies["response_body"] == nil {
+		t.Fatalf("unexpected log entry: %v", notFoundBodies)
+	}
+
+	client.SetStructuredLogger(nil)
+	buf.Reset()
+
+	if _, err := client.GetInstance(context.Background(), 456); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if buf.Len() != 0 {
+		t.Fatal("expected logger to be removed")
+	}
+}
+
+func TestRedactSensitiveFields(t *testing.T) {
+	redacted := linodego.RedactSensitiveFields([]byte(`{"label":"web","token":"abc","users":[{"password":"p","username":"u"}]}`))
+	expected := `{"label":"web","token":"[REDACTED]","users":[{"password":"[REDACTED]","username":"u"}]}`
+
+	if string(redacted) != expected {
+		t.Fatalf("expected %s, got %s", expected, redacted)
+	}
+
+	if redacted := linodego.RedactSensitiveFields([]byte("not json")); string(redacted) != "[non-JSON body omitted]" {
+		t.Fatalf("unexpected redaction of non-JSON body: %s", redacted)
+	}
+}
@@ -25,6 +25,27 @@ func TestListDatabases(t *testing.T) {
 	assert.NotEmpty(t, databases, "Expected non-empty database list")
 }
 
+func TestListDatabases_EngineType(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("databases/instances", map[string]any{
+		"data": []map[string]any{
+			{"id": 1, "engine": "mysql", "version": "8.0.30"},
+			{"id": 2, "engine": "postgresql", "version": "16.4"},
+		},
+		"pages":   1,
+		"results": 2,
+	})
+
+	databases, err := base.Client.ListDatabases(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, databases, 2)
+	assert.Equal(t, linodego.DatabaseEngineTypeMySQL, databases[0].EngineType())
+	assert.Equal(t, linodego.DatabaseEngineTypePostgres, databases[1].EngineType())
+}
+
 func TestGetDatabaseEngine(t *testing.T) {
 	fixtureData, err := fixtures.GetFixture("database_engine_get")
 	assert.NoError(t, err)
@@ -43,6 +64,28 @@ func TestGetDatabaseEngine(t *testing.T) {
 	assert.Equal(t, "8.0", databaseEngine.Version, "Expected MySQL 8.0 version")
 }
 
+func TestValidateDatabaseEngine(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("databases/engines", map[string]any{
+		"data": []map[string]any{
+			{"id": "mysql/8", "engine": "mysql", "version": "8"},
+			{"id": "postgresql/16", "engine": "postgresql", "version": "16"},
+		},
+		"pages":   1,
+		"results": 2,
+	})
+
+	assert.NoError(t, base.Client.ValidateDatabaseEngine(context.Background(), "mysql/8"))
+	assert.NoError(t, base.Client.ValidateDatabaseEngine(context.Background(), "postgresql/16"))
+
+	err := base.Client.ValidateDatabaseEngine(context.Background(), "postgresql/61")
+	assert.ErrorIs(t, err, linodego.ErrUnknownDatabaseEngine)
+	assert.EqualError(t, err, `unknown database engine "postgresql/61", available engines are mysql/8, postgresql/16`)
+}
+
 func TestListDatabaseTypes(t *testing.T) {
 	fixtureData, err := fixtures.GetFixture("database_types_list")
 	assert.NoError(t, err)
@@ -57,6 +100,41 @@ func TestListDatabaseTypes(t *testing.T) {
 	assert.NotEmpty(t, databaseTypes, "Expected non-empty database types list")
 }
 
+func TestDatabaseType_EnginePrices(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("databases/types/g6-nanode-1", map[string]any{
+		"id":     "g6-nanode-1",
+		"label":  "DBaaS - Nanode 1GB",
+		"class":  "nanode",
+		"vcpus":  1,
+		"disk":   25600,
+		"memory": 1024,
+		"engines": map[string]any{
+			"mysql": []map[string]any{
+				{
+					"quantity":      1,
+					"price":         map[string]any{"hourly": 0.0225, "monthly": 15},
+					"region_prices": []map[string]any{{"id": "id-cgk", "hourly": 0.027, "monthly": 18}},
+				},
+				{"quantity": 3, "price": map[string]any{"hourly": 0.0525, "monthly": 35}},
+			},
+		},
+	})
+
+	databaseType, err := base.Client.GetDatabaseType(context.Background(), nil, "g6-nanode-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, databaseType.VirtualCPUs)
+	assert.Equal(t, 1024, databaseType.Memory)
+	assert.Len(t, databaseType.Engines.MySQL, 2)
+
+	single := databaseType.Engines.MySQL[0]
+	assert.Equal(t, linodego.ClusterPrice{Hourly: 0.027, Monthly: 18}, single.PriceForRegion("id-cgk"))
+	assert.Equal(t, linodego.ClusterPrice{Hourly: 0.0225, Monthly: 15}, single.PriceForRegion("us-east"))
+}
+
 func TestUnmarshalDatabase(t *testing.T) {
 	fixtureData, err := fixtures.GetFixture("database_unmarshal")
 	assert.NoError(t, err)
@@ -2,7 +2,10 @@ package unit
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -198,6 +201,39 @@ func TestDomain_GetDomainZoneFile(t *testing.T) {
 	assert.Equal(t, expectedZoneFile, domain.ZoneFile)
 }
 
+func TestDomain_GetDomainZoneFileReader(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("domains/1234/zone-file", map[string]any{"zone_file": []string{"example.com. 300 IN A 192.0.2.1"}})
+
+	body, err := base.Client.GetDomainZoneFileReader(context.Background(), 1234)
+	assert.NoError(t, err)
+
+	raw, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.NoError(t, body.Close())
+	assert.JSONEq(t, `{"zone_file": ["example.com. 300 IN A 192.0.2.1"]}`, string(raw))
+}
+
+func TestDomain_GetDomainZoneFileSlave(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	domainID := 1234
+	base.MockGet(formatMockAPIPath("domains/%d/zone-file", domainID), map[string]any{"zone_file": []string{}})
+	base.MockGet(formatMockAPIPath("domains/%d", domainID), map[string]any{"id": domainID, "type": "slave"})
+
+	zoneFile, err := base.Client.GetDomainZoneFile(context.Background(), domainID)
+	assert.NoError(t, err)
+	assert.Empty(t, zoneFile.ZoneFile)
+
+	_, err = base.Client.GetMasterDomainZoneFile(context.Background(), domainID)
+	assert.ErrorContains(t, err, "slave zone")
+}
+
 func TestDomain_Clone(t *testing.T) {
 	fixtureData, err := fixtures.GetFixture("domain_clone")
 	assert.NoError(t, err)
@@ -243,3 +279,214 @@ func TestDomain_Import(t *testing.T) {
 	assert.Equal(t, "example.org", domain.Domain)
 	assert.Equal(t, "admin@example.org", domain.SOAEmail)
 }
+
+func TestDomain_ImportFromZoneFile(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	zoneFile := `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.oldprovider.net. john\.doe.example.com. (
+		2024010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		300 )      ; minimum
+	IN	NS	ns1.oldprovider.net.
+	IN	MX	10 mail
+www	300	IN	A	192.0.2.1
+	IN	AAAA	2001:db8::1
+blog	IN	CNAME	www
+dev	IN	NS	ns1.dev.example.org.
+@	IN	TXT	"v=spf1 include:_spf.example.net " "~all"
+_sip._tcp	IN	SRV	10 20 5060 sip.example.com.
+@	IN	CAA	0 issue "letsencrypt.org"
+ptr	IN	PTR	host.example.com.
+other.example.org.	IN	A	192.0.2.2
+`
+
+	domainOpts, records := mockZoneImport(&base)
+
+	domain, skipped, err := base.Client.ImportDomainFromZoneFile(context.Background(), zoneFile, linodego.DomainZoneFileImportOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1234, domain.ID)
+
+	assert.Equal(t, "example.com", domainOpts.Domain)
+	assert.Equal(t, linodego.DomainTypeMaster, domainOpts.Type)
+	assert.Equal(t, "john.doe@example.com", domainOpts.SOAEmail)
+	assert.Equal(t, 7200, domainOpts.RefreshSec)
+	assert.Equal(t, 3600, domainOpts.RetrySec)
+	assert.Equal(t, 1209600, domainOpts.ExpireSec)
+	assert.Equal(t, 3600, domainOpts.TTLSec)
+
+	assert.Equal(t, []linodego.DomainRecordCreateOptions{
+		{Type: linodego.RecordTypeMX, Name: "", Target: "mail.example.com", Priority: linodego.Pointer(10)},
+		{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1", TTLSec: 300},
+		{Type: linodego.RecordTypeAAAA, Name: "www", Target: "2001:db8::1"},
+		{Type: linodego.RecordTypeCNAME, Name: "blog", Target: "www.example.com"},
+		{Type: linodego.RecordTypeNS, Name: "dev", Target: "ns1.dev.example.org"},
+		{Type: linodego.RecordTypeTXT, Name: "", Target: "v=spf1 include:_spf.example.net ~all"},
+		{
+			Type: linodego.RecordTypeSRV, Name: "", Target: "sip.example.com",
+			Priority: linodego.Pointer(10), Weight: linodego.Pointer(20), Port: linodego.Pointer(5060),
+			Service: linodego.Pointer("sip"), Protocol: linodego.Pointer("tcp"),
+		},
+		{Type: linodego.RecordTypeCAA, Name: "", Target: "letsencrypt.org", Tag: linodego.Pointer("issue")},
+	}, *records)
+
+	assert.Len(t, skipped, 3)
+	assert.Equal(t, "example.com.\t3600\tIN\tNS\tns1.oldprovider.net.", skipped[0].Text)
+	assert.Equal(t, "NS records for the zone apex are managed by Linode", skipped[0].Reason)
+	assert.Equal(t, "unsupported record type PTR", skipped[1].Reason)
+	assert.Equal(t, "name other.example.org is outside of zone example.com", skipped[2].Reason)
+}
+
+func TestDomain_ImportFromZoneFileEscapesAndRelativeOrigin(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	zoneFile := `$ORIGIN example.com.
+@	IN	SOA	ns1.example.net. first\046last.example.com. ( 1 7200 3600 1209600 300 )
+@	IN	TXT	"hello\032world" "\"quoted\""
+$ORIGIN staging
+www	IN	A	192.0.2.1
+@	IN	TXT	"v=1"
+`
+
+	domainOpts, records := mockZoneImport(&base)
+
+	_, skipped, err := base.Client.ImportDomainFromZoneFile(context.Background(), zoneFile, linodego.DomainZoneFileImportOptions{Concurrency: 1})
+	assert.NoError(t, err)
+	assert.Empty(t, skipped)
+
+	assert.Equal(t, "example.com", domainOpts.Domain)
+	assert.Equal(t, "first.last@example.com", domainOpts.SOAEmail)
+
+	assert.Equal(t, []linodego.DomainRecordCreateOptions{
+		{Type: linodego.RecordTypeTXT, Name: "", Target: `hello world"quoted"`},
+		{Type: linodego.RecordTypeA, Name: "www.staging", Target: "192.0.2.1"},
+		{Type: linodego.RecordTypeTXT, Name: "staging", Target: "v=1"},
+	}, *records)
+}
+
+func TestDomain_ImportFromZoneFileDirectives(t *testing.T) {
+	soa := "@\tIN\tSOA\tns1.example.net. hostmaster.example.com. ( 1 7200 3600 1209600 300 )\n"
+
+	tests := []struct {
+		name     string
+		zoneFile string
+		domain   string
+		ttl      int
+		records  []linodego.DomainRecordCreateOptions
+		err      string
+	}{
+		{
+			name:     "$ORIGIN",
+			zoneFile: "$ORIGIN example.com.\n$TTL 300\n" + soa + "www\tIN\tA\t192.0.2.1\n",
+			ttl:      300,
+			records:  []linodego.DomainRecordCreateOptions{{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"}},
+		},
+		{
+			name:     "domain without $ORIGIN",
+			zoneFile: "$TTL 300\n" + soa + "www\tIN\tA\t192.0.2.1\n",
+			domain:   "example.com",
+			ttl:      300,
+			records:  []linodego.DomainRecordCreateOptions{{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"}},
+		},
+		{
+			name:     "$TTL",
+			zoneFile: "$ORIGIN example.com.\n$TTL 1h\n" + soa + "www\tIN\tA\t192.0.2.1\n$TTL 60\napi\tIN\tA\t192.0.2.2\n",
+			ttl:      3600,
+			records: []linodego.DomainRecordCreateOptions{
+				{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"},
+				{Type: linodego.RecordTypeA, Name: "api", Target: "192.0.2.2", TTLSec: 60},
+			},
+		},
+		{
+			name:     "$GENERATE",
+			zoneFile: "$ORIGIN example.com.\n$TTL 300\n" + soa + "$GENERATE 1-2 host-$ 300 IN A 192.0.2.$\n",
+			ttl:      300,
+			records: []linodego.DomainRecordCreateOptions{
+				{Type: linodego.RecordTypeA, Name: "host-1", Target: "192.0.2.1"},
+				{Type: linodego.RecordTypeA, Name: "host-2", Target: "192.0.2.2"},
+			},
+		},
+		{
+			name:     "$INCLUDE",
+			zoneFile: "$ORIGIN example.com.\n$TTL 300\n" + soa + "$INCLUDE /etc/passwd\n",
+			err:      "$INCLUDE directive not allowed",
+		},
+		{
+			name:     "no $ORIGIN or domain",
+			zoneFile: "www IN A 192.0.2.1\n",
+			err:      "bad owner name",
+		},
+		{
+			name:     "no SOA or domain",
+			zoneFile: "www.example.com. 300 IN A 192.0.2.1\n",
+			err:      "the zone file has no SOA record and no domain was provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var base ClientBaseCase
+			base.SetUp(t)
+			defer base.TearDown(t)
+
+			domainOpts, records := mockZoneImport(&base)
+
+			_, skipped, err := base.Client.ImportDomainFromZoneFile(context.Background(), tc.zoneFile, linodego.DomainZoneFileImportOptions{
+				Domain:      tc.domain,
+				Concurrency: 1,
+			})
+
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				assert.Equal(t, 0, httpmock.GetTotalCallCount())
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Empty(t, skipped)
+			assert.Equal(t, "example.com", domainOpts.Domain)
+			assert.Equal(t, "hostmaster@example.com", domainOpts.SOAEmail)
+			assert.Equal(t, tc.ttl, domainOpts.TTLSec)
+			assert.Equal(t, tc.records, *records)
+		})
+	}
+}
+
+// mockZoneImport mocks the requests made by ImportDomainFromZoneFile, returning the
+// options the Domain was created with and the options of each record created.
+func mockZoneImport(base *ClientBaseCase) (*linodego.DomainCreateOptions, *[]linodego.DomainRecordCreateOptions) {
+	var (
+		domainOpts linodego.DomainCreateOptions
+		records    []linodego.DomainRecordCreateOptions
+	)
+
+	httpmock.RegisterResponder("POST", base.BaseURL+"domains",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&domainOpts); err != nil {
+				return nil, err
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": 1234, "domain": domainOpts.Domain})
+		})
+
+	httpmock.RegisterResponder("POST", base.BaseURL+"domains/1234/records",
+		func(req *http.Request) (*http.Response, error) {
+			var opts linodego.DomainRecordCreateOptions
+			if err := json.NewDecoder(req.Body).Decode(&opts); err != nil {
+				return nil, err
+			}
+
+			records = append(records, opts)
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": len(records)})
+		})
+
+	return &domainOpts, &records
+}
@@ -2,7 +2,9 @@ package unit
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -145,7 +147,7 @@ func TestDomainRecord_Update(t *testing.T) {
 
 	requestData := linodego.DomainRecordUpdateOptions{
 		Type:     linodego.RecordTypeA,
-		Name:     "test",
+		Name:     linodego.NullOf("test"),
 		Target:   "192.0.2.0",
 		Priority: &priority,
 		Weight:   &weight,
@@ -192,3 +194,107 @@ func TestDomainRecord_Delete(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDomainRecords_CreateBatch(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("POST", base.BaseURL+"domains/1234/records",
+		func(req *http.Request) (*http.Response, error) {
+			var opts linodego.DomainRecordCreateOptions
+			if err := json.NewDecoder(req.Body).Decode(&opts); err != nil {
+				return nil, err
+			}
+
+			if opts.Name == "bad" {
+				return httpmock.NewJsonResponse(http.StatusBadRequest, linodego.APIError{
+					Errors: []linodego.APIErrorReason{{Field: "target", Reason: "Invalid target"}},
+				})
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"id": 100, "type": opts.Type, "name": opts.Name, "target": opts.Target,
+			})
+		})
+
+	records := []linodego.DomainRecordCreateOptions{
+		{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"},
+		{Type: linodego.RecordTypeA, Name: "bad", Target: "not-an-ip"},
+		{Type: linodego.RecordTypeCNAME, Name: "mail", Target: "www.example.com"},
+		{Type: linodego.RecordTypeTXT, Name: "txt", Target: "hello"},
+	}
+
+	result := base.Client.CreateDomainRecords(context.Background(), 1234, records, 2)
+
+	assert.Len(t, result.Created, 3)
+	assert.Equal(t, []string{"www", "mail", "txt"}, []string{result.Created[0].Name, result.Created[1].Name, result.Created[2].Name})
+
+	assert.Len(t, result.Failed, 1)
+	assert.Equal(t, 1, result.Failed[0].Index)
+	assert.Equal(t, "bad", result.Failed[0].Options.Name)
+	assert.True(t, linodego.ErrHasStatus(result.Failed[0].Err, http.StatusBadRequest))
+
+	assert.ErrorContains(t, result.Err(), "record 1 (A bad)")
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+func TestDomainRecords_CreateBatchCancelled(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpmock.RegisterResponder("POST", base.BaseURL+"domains/1234/records",
+		func(_ *http.Request) (*http.Response, error) {
+			// Cancel once the first record has been created
+			cancel()
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": 100, "name": "www"})
+		})
+
+	records := []linodego.DomainRecordCreateOptions{
+		{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"},
+		{Type: linodego.RecordTypeA, Name: "api", Target: "192.0.2.2"},
+		{Type: linodego.RecordTypeA, Name: "mail", Target: "192.0.2.3"},
+	}
+
+	result := base.Client.CreateDomainRecords(ctx, 1234, records, 1)
+
+	assert.Len(t, result.Created, 1)
+	assert.Len(t, result.Failed, 2)
+
+	for _, failure := range result.Failed {
+		assert.ErrorIs(t, failure.Err, context.Canceled)
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestDomainRecord_UpdateClearName(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	var requestBody map[string]any
+
+	httpmock.RegisterResponder("PUT", base.BaseURL+"domains/1234/records/123456",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+				return nil, err
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": 123456, "name": ""})
+		})
+
+	// An empty name moves the record to the zone apex rather than being omitted
+	record, err := base.Client.UpdateDomainRecord(context.Background(), 1234, 123456, linodego.DomainRecordUpdateOptions{
+		Name:   linodego.NullOf(""),
+		Target: "192.0.2.1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "", record.Name)
+	assert.Equal(t, map[string]any{"name": "", "target": "192.0.2.1"}, requestBody)
+}
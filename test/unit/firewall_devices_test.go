@@ -125,3 +125,38 @@ func TestFirewallDevice_Delete(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestFirewallDevice_AttachDetachByEntity(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("networking/firewalls/123/devices", map[string]any{
+		"page": 1, "pages": 1, "results": 1,
+		"data": []any{
+			map[string]any{"id": 7, "entity": map[string]any{"id": 456, "type": "linode"}},
+		},
+	})
+	base.MockPost("networking/firewalls/123/devices", map[string]any{
+		"id": 8, "entity": map[string]any{"id": 789, "type": "nodebalancer"},
+	})
+	base.MockDelete("networking/firewalls/123/devices/7", nil)
+
+	// Already attached, no device should be created
+	device, err := base.Client.AttachFirewallToInstance(context.Background(), 123, 456)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, device.ID)
+
+	device, err = base.Client.AttachFirewallToNodeBalancer(context.Background(), 123, 789)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, device.ID)
+
+	assert.NoError(t, base.Client.DetachFirewallFromInstance(context.Background(), 123, 456))
+
+	// Not attached, nothing should be deleted
+	assert.NoError(t, base.Client.DetachFirewallFromNodeBalancer(context.Background(), 123, 456))
+
+	calls := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, calls["POST "+base.BaseURL+"networking/firewalls/123/devices"])
+	assert.Equal(t, 1, calls["DELETE "+base.BaseURL+"networking/firewalls/123/devices/7"])
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
+	"github.com/linode/linodego/internal/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -111,3 +113,64 @@ func TestFirewallRule_Update(t *testing.T) {
 	assert.ElementsMatch(t, []string{"192.0.2.0/24", "198.51.100.2/32"}, *firewallRule.Outbound[0].Addresses.IPv4)
 	assert.ElementsMatch(t, []string{"2001:DB8::/128"}, *firewallRule.Outbound[0].Addresses.IPv6)
 }
+
+func TestFirewallRule_Move(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	rule := func(label string) linodego.FirewallRule {
+		return linodego.FirewallRule{Action: "ACCEPT", Label: label, Protocol: linodego.TCP}
+	}
+
+	current := linodego.FirewallRuleSet{
+		Inbound:        []linodego.FirewallRule{rule("a"), rule("b"), rule("c")},
+		InboundPolicy:  "DROP",
+		Outbound:       []linodego.FirewallRule{rule("out")},
+		OutboundPolicy: "ACCEPT",
+	}
+
+	expected := linodego.FirewallRuleSet{
+		Inbound:        []linodego.FirewallRule{rule("b"), rule("c"), rule("a")},
+		InboundPolicy:  "DROP",
+		Outbound:       []linodego.FirewallRule{rule("out")},
+		OutboundPolicy: "ACCEPT",
+	}
+
+	base.MockGet("networking/firewalls/123/rules", current)
+	httpmock.RegisterResponder("PUT", base.BaseURL+"networking/firewalls/123/rules",
+		testutil.MockRequestBodyValidate(t, expected, expected))
+
+	hash, err := current.Hash()
+	assert.NoError(t, err)
+
+	rules, err := base.Client.MoveFirewallRule(context.Background(), 123, linodego.FirewallRuleInbound, 0, 2, hash)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", rules.Inbound[0].Label)
+	assert.Equal(t, "a", rules.Inbound[2].Label)
+}
+
+func TestFirewallRule_MoveInvalid(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("networking/firewalls/123/rules", linodego.FirewallRuleSet{
+		Inbound:       []linodego.FirewallRule{{Label: "a"}, {Label: "b"}},
+		InboundPolicy: "DROP",
+	})
+
+	_, err := base.Client.MoveFirewallRule(context.Background(), 123, linodego.FirewallRuleInbound, 0, 2, "")
+	assert.ErrorContains(t, err, "toIndex 2 is out of range")
+
+	_, err = base.Client.MoveFirewallRule(context.Background(), 123, linodego.FirewallRuleOutbound, 0, 0, "")
+	assert.ErrorContains(t, err, "fromIndex 0 is out of range")
+
+	_, err = base.Client.MoveFirewallRule(context.Background(), 123, "sideways", 0, 1, "")
+	assert.ErrorContains(t, err, "invalid firewall rule direction")
+
+	_, err = base.Client.MoveFirewallRule(context.Background(), 123, linodego.FirewallRuleInbound, 0, 1, "stale")
+	assert.ErrorContains(t, err, "have changed")
+
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["PUT "+base.BaseURL+"networking/firewalls/123/rules"])
+}
@@ -3,6 +3,9 @@ package unit
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -188,6 +191,7 @@ func TestImage_Upload(t *testing.T) {
 	}
 
 	base.MockPost("images/upload", fixtureData)
+	base.MockGet("images/linode%2Fdebian11", fixtureData.(map[string]any)["image"])
 
 	image, err := base.Client.UploadImage(context.Background(), requestData)
 	assert.NoError(t, err)
@@ -267,3 +271,131 @@ func TestImage_Replicate(t *testing.T) {
 
 	assert.ElementsMatch(t, []string{"repair-image", "fix-1"}, image.Tags)
 }
+
+func TestImage_UploadProgress(t *testing.T) {
+	var received []byte
+	var contentLength int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentLength = r.ContentLength
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockPost("images/upload", map[string]any{
+		"image":     map[string]any{"id": "private/123", "status": "pending_upload"},
+		"upload_to": server.URL,
+	})
+	base.MockGet("images/private%2F123", map[string]any{"id": "private/123", "status": "pending"})
+
+	var uploaded int64
+
+	image, err := base.Client.UploadImage(context.Background(), linodego.ImageUploadOptions{
+		Region: "us-iad",
+		Label:  "test-image",
+		Image:  strings.NewReader("mock image data"),
+		Progress: func(n int64) {
+			uploaded = n
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "private/123", image.ID)
+	assert.Equal(t, linodego.ImageStatus("pending"), image.Status)
+	assert.Equal(t, "mock image data", string(received))
+	assert.Equal(t, int64(len("mock image data")), contentLength)
+	assert.Equal(t, int64(len("mock image data")), uploaded)
+}
+
+func TestImage_UploadUnknownLength(t *testing.T) {
+	var received []byte
+	var transferEncoding []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		transferEncoding = r.TransferEncoding
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockPost("images/upload", map[string]any{
+		"image":     map[string]any{"id": "private/123", "status": "pending_upload"},
+		"upload_to": server.URL,
+	})
+	base.MockGet("images/private%2F123", map[string]any{"id": "private/123", "status": "pending"})
+
+	// A pipe has no length, so the image must be streamed rather than buffered
+	pr, pw := io.Pipe()
+	go func() {
+		_, _ = pw.Write([]byte("mock image data"))
+		_ = pw.Close()
+	}()
+
+	image, err := base.Client.UploadImage(context.Background(), linodego.ImageUploadOptions{
+		Region: "us-iad",
+		Label:  "test-image",
+		Image:  pr,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, linodego.ImageStatus("pending"), image.Status)
+	assert.Equal(t, "mock image data", string(received))
+	assert.Equal(t, []string{"chunked"}, transferEncoding)
+}
+
+func TestImage_EndpointCache(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("images", map[string]any{
+		"page": 1, "pages": 1, "results": 1,
+		"data": []map[string]any{{"id": "private/123", "status": "available"}},
+	})
+	base.MockGet("images/private%2F123", map[string]any{"id": "private/123", "status": "available"})
+	base.MockPut("images/private%2F123", map[string]any{"id": "private/123", "label": "updated"})
+
+	listCalls := func() int { return httpmock.GetCallCountInfo()["GET "+base.BaseURL+"images"] }
+	getCalls := func() int { return httpmock.GetCallCountInfo()["GET "+base.BaseURL+"images/private%2F123"] }
+
+	// Images are not cached until enabled
+	for range 2 {
+		_, err := base.Client.ListImages(context.Background(), nil)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, listCalls())
+
+	base.Client.SetEndpointCache(time.Hour)
+
+	for range 2 {
+		_, err := base.Client.ListImages(context.Background(), nil)
+		assert.NoError(t, err)
+
+		image, err := base.Client.GetImage(context.Background(), "private/123")
+		assert.NoError(t, err)
+		assert.Equal(t, "private/123", image.ID)
+	}
+	assert.Equal(t, 3, listCalls())
+	assert.Equal(t, 1, getCalls())
+
+	// Modifying an Image invalidates the cached Images
+	_, err := base.Client.UpdateImage(context.Background(), "private/123", linodego.ImageUpdateOptions{Label: "updated"})
+	assert.NoError(t, err)
+
+	_, err = base.Client.ListImages(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = base.Client.GetImage(context.Background(), "private/123")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, listCalls())
+	assert.Equal(t, 2, getCalls())
+}
@@ -2,8 +2,11 @@ package unit
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -98,3 +101,122 @@ func TestInstanceBackup_Restore(t *testing.T) {
 	err := base.Client.RestoreInstanceBackup(context.Background(), 123, 1, restoreOptions)
 	assert.NoError(t, err)
 }
+
+func TestInstanceBackups_UpdateSchedule(t *testing.T) {
+	client := createMockClient(t)
+
+	requestData := map[string]any{
+		"backups": map[string]any{
+			"schedule": map[string]any{
+				"day":    "Sunday",
+				"window": "W4",
+			},
+		},
+	}
+
+	responseData := map[string]any{
+		"id": 123,
+		"backups": map[string]any{
+			"enabled": true,
+			"schedule": map[string]any{
+				"day":    "Sunday",
+				"window": "W4",
+			},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123"),
+		mockRequestBodyValidate(t, requestData, responseData))
+
+	backups, err := client.UpdateInstanceBackupSchedule(context.Background(), 123, linodego.InstanceBackupScheduleOptions{
+		Day:    "Sunday",
+		Window: "W4",
+	})
+	assert.NoError(t, err)
+	assert.True(t, backups.Enabled)
+	assert.Equal(t, "Sunday", backups.Schedule.Day)
+	assert.Equal(t, "W4", backups.Schedule.Window)
+}
+
+func TestInstanceBackups_UpdateScheduleInvalidWindow(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.UpdateInstanceBackupSchedule(context.Background(), 123, linodego.InstanceBackupScheduleOptions{
+		Window: "W3",
+	})
+	assert.ErrorContains(t, err, "invalid backup schedule window")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestInstanceBackup_RestoreAndWait(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("instance_snapshot_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	base.MockEventFeed(map[string]any{
+		"id":     300,
+		"action": "backups_restore",
+		"entity": map[string]any{"id": 456, "type": "linode"},
+	}, "POST", "linode/instances/123/backups/1/restore", httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{}))
+
+	base.MockGet("linode/instances/123/backups/1", fixtureData)
+	base.MockGet("linode/instances/456", map[string]any{"id": 456, "specs": map[string]any{"disk": 10240}})
+
+	result, err := base.Client.RestoreInstanceBackupAndWait(context.Background(), 123, 1, linodego.RestoreInstanceOptions{
+		LinodeID:  456,
+		Overwrite: true,
+	}, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 300, result.ID)
+	assert.Equal(t, linodego.EventFinished, result.Status)
+}
+
+func TestInstanceBackup_RestoreAndWaitDoesNotFit(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("instance_snapshot_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances/123/backups/1", fixtureData)
+	base.MockGet("linode/instances/456", map[string]any{"id": 456, "specs": map[string]any{"disk": 25600}})
+	base.MockGet("linode/instances/456/disks", map[string]any{
+		"data":    []any{map[string]any{"id": 1, "size": 20480}},
+		"page":    1,
+		"pages":   1,
+		"results": 1,
+	})
+
+	_, err = base.Client.RestoreInstanceBackupAndWait(context.Background(), 123, 1, linodego.RestoreInstanceOptions{
+		LinodeID: 456,
+	}, 5)
+	assert.ErrorIs(t, err, linodego.ErrBackupDoesNotFit)
+	assert.ErrorContains(t, err, "backup 1 requires 10240 MB but Linode 456 has 5120 MB available")
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"linode/instances/123/backups/1/restore"])
+}
+
+func TestInstanceBackup_RestoreToNew(t *testing.T) {
+	client := createMockClient(t)
+
+	requestData := linodego.InstanceCreateOptions{
+		Region:   "us-east",
+		Type:     "g6-standard-2",
+		BackupID: 1,
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances"),
+		mockRequestBodyValidate(t, requestData, map[string]any{"id": 789}))
+
+	instance, err := client.RestoreInstanceBackupToNew(context.Background(), 1, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-standard-2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 789, instance.ID)
+}
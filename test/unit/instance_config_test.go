@@ -95,3 +95,32 @@ func TestInstanceConfig_Delete(t *testing.T) {
 	err := base.Client.DeleteInstanceConfig(context.Background(), 123, 1)
 	assert.NoError(t, err)
 }
+
+func TestInstanceConfig_SetInterfaces(t *testing.T) {
+	var opts linodego.InstanceConfigUpdateOptions
+
+	err := opts.SetInterfaces(
+		linodego.InstanceConfigInterfaceCreateOptions{Purpose: linodego.InterfacePurposePublic, Primary: true},
+		linodego.InstanceConfigInterfaceCreateOptions{Purpose: linodego.InterfacePurposeVLAN, Label: "vlan-1"},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, opts.Interfaces, 2)
+	assert.Equal(t, "vlan-1", opts.Interfaces[1].Label)
+
+	err = opts.SetInterfaces(
+		linodego.InstanceConfigInterfaceCreateOptions{Purpose: linodego.InterfacePurposePublic, Primary: true},
+		linodego.InstanceConfigInterfaceCreateOptions{Purpose: linodego.InterfacePurposeVPC, Primary: true},
+	)
+	assert.EqualError(t, err, "interfaces eth0 and eth1 are both marked primary")
+	assert.Len(t, opts.Interfaces, 2)
+
+	var createOpts linodego.InstanceConfigCreateOptions
+
+	err = createOpts.SetInterfaces(
+		linodego.InstanceConfigInterfaceCreateOptions{Purpose: linodego.InterfacePurposeVLAN},
+		linodego.InstanceConfigInterfaceCreateOptions{Purpose: linodego.InterfacePurposePublic},
+		linodego.InstanceConfigInterfaceCreateOptions{Purpose: linodego.InterfacePurposePublic},
+	)
+	assert.EqualError(t, err, "interfaces eth1 and eth2 both have the public purpose")
+	assert.Nil(t, createOpts.Interfaces)
+}
@@ -2,8 +2,11 @@ package unit
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -159,3 +162,140 @@ func TestInstanceDisk_PasswordReset(t *testing.T) {
 	err := base.Client.PasswordResetInstanceDisk(context.Background(), 123, 1, "new-password")
 	assert.NoError(t, err)
 }
+
+func TestInstanceDisk_CreateAndWait(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("instance_disk_create")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	base.MockEventFeed(map[string]any{
+		"id":               100,
+		"action":           "disk_create",
+		"entity":           map[string]any{"id": 123, "type": "linode"},
+		"secondary_entity": map[string]any{"id": 3, "type": "disk"},
+	}, "POST", "linode/instances/123/disks", httpmock.NewJsonResponderOrPanic(http.StatusOK, fixtureData))
+
+	base.MockGet("linode/instances/123/disks/3", fixtureData)
+
+	disk, err := base.Client.CreateInstanceDiskAndWait(context.Background(), 123, linodego.InstanceDiskCreateOptions{
+		Label: "New Disk",
+		Size:  20480,
+	}, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, disk.ID)
+	assert.Equal(t, linodego.DiskReady, disk.Status)
+}
+
+func TestInstanceDisk_CreateAndWaitFailed(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("instance_disk_create")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	base.MockEventFeed(map[string]any{
+		"id":               100,
+		"action":           "disk_create",
+		"entity":           map[string]any{"id": 123, "type": "linode"},
+		"secondary_entity": map[string]any{"id": 3, "type": "disk"},
+	}, "POST", "linode/instances/123/disks", httpmock.NewJsonResponderOrPanic(http.StatusOK, fixtureData))
+
+	base.MockGet("account/events/100", map[string]any{
+		"id": 100, "action": "disk_create", "status": "failed", "message": "Image not found",
+	})
+
+	_, err = base.Client.CreateInstanceDiskAndWait(context.Background(), 123, linodego.InstanceDiskCreateOptions{
+		Label: "New Disk",
+		Size:  20480,
+	}, 5)
+	assert.ErrorContains(t, err, "Image not found")
+}
+
+func TestInstanceDisk_ResizeAndWait(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	base.MockEventFeed(map[string]any{
+		"id":               100,
+		"action":           "disk_resize",
+		"entity":           map[string]any{"id": 123, "type": "linode"},
+		"secondary_entity": map[string]any{"id": 3, "type": "disk"},
+	}, "POST", "linode/instances/123/disks/3/resize", httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{}))
+
+	base.MockGet("linode/instances/123", map[string]any{"id": 123, "type": "g6-standard-1"})
+	base.MockGet("linode/types/g6-standard-1", map[string]any{"id": "g6-standard-1", "disk": 51200})
+	base.MockGet("linode/instances/123/disks", map[string]any{
+		"page": 1, "pages": 1, "results": 2,
+		"data": []any{
+			map[string]any{"id": 3, "size": 25600},
+			map[string]any{"id": 4, "size": 512},
+		},
+	})
+	base.MockGet("linode/instances/123/disks/3", map[string]any{"id": 3, "size": 50688, "status": "ready"})
+
+	disk, err := base.Client.ResizeInstanceDiskAndWait(context.Background(), 123, 3, 50688, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 50688, disk.Size)
+}
+
+func TestInstanceDisk_ResizeAndWaitDoesNotFit(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances/123", map[string]any{"id": 123, "type": "g6-standard-1"})
+	base.MockGet("linode/types/g6-standard-1", map[string]any{"id": "g6-standard-1", "disk": 51200})
+	base.MockGet("linode/instances/123/disks", map[string]any{
+		"page": 1, "pages": 1, "results": 2,
+		"data": []any{
+			map[string]any{"id": 3, "size": 25600},
+			map[string]any{"id": 4, "size": 512},
+		},
+	})
+
+	_, err := base.Client.ResizeInstanceDiskAndWait(context.Background(), 123, 3, 51200, 5)
+	assert.ErrorIs(t, err, linodego.ErrDiskDoesNotFit)
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"linode/instances/123/disks/3/resize"])
+}
+
+func TestInstanceDisk_CloneToInstance(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	cloned := base.MockEventFeed(map[string]any{
+		"id":               400,
+		"action":           "linode_clone",
+		"entity":           map[string]any{"id": 123, "type": "linode"},
+		"secondary_entity": map[string]any{"id": 456, "type": "linode"},
+	}, "POST", "linode/instances/123/clone",
+		mockRequestBodyValidate(t, linodego.InstanceCloneOptions{LinodeID: 456, Disks: []int{3}}, map[string]any{"id": 456}))
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/instances/456/disks",
+		func(req *http.Request) (*http.Response, error) {
+			data := []any{map[string]any{"id": 10, "label": "existing"}}
+			if cloned() {
+				data = append(data, map[string]any{"id": 11, "label": "cloned", "status": "ready"})
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"page": 1, "pages": 1, "results": len(data), "data": data})
+		})
+	base.MockGet("linode/instances/456", map[string]any{"id": 456, "status": "offline"})
+
+	disk, err := base.Client.CloneInstanceDiskToInstance(context.Background(), 123, 3, 456, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, disk.ID)
+	assert.Equal(t, "cloned", disk.Label)
+}
@@ -2,9 +2,13 @@ package unit
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
@@ -94,6 +98,186 @@ func TestInstance_Migrate(t *testing.T) {
 	}
 }
 
+func TestInstance_MigrateWarmUnsupported(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "/linode/instances/123456/migrate"),
+		httpmock.NewJsonResponderOrPanic(http.StatusBadRequest, map[string]any{
+			"errors": []map[string]string{{"field": "type", "reason": "Warm migrations are not available for this Linode."}},
+		}))
+
+	err := client.MigrateInstance(context.Background(), 123456, linodego.InstanceMigrateOptions{
+		Type: linodego.WarmMigration,
+	})
+	assert.ErrorIs(t, err, linodego.ErrWarmMigrationUnsupported)
+	assert.True(t, linodego.ErrHasStatus(err, http.StatusBadRequest))
+
+	err = client.MigrateInstance(context.Background(), 123456, linodego.InstanceMigrateOptions{
+		Type: linodego.ColdMigration,
+	})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, linodego.ErrWarmMigrationUnsupported)
+}
+
+func TestInstance_MigrateWarmOtherError(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "/linode/instances/123456/migrate"),
+		httpmock.NewJsonResponderOrPanic(http.StatusBadRequest, map[string]any{
+			"errors": []map[string]string{{"field": "region", "reason": "Region does not support warm migrations."}},
+		}))
+
+	err := client.MigrateInstance(context.Background(), 123456, linodego.InstanceMigrateOptions{
+		Type:   linodego.WarmMigration,
+		Region: "us-west",
+	})
+	assert.True(t, linodego.ErrHasStatus(err, http.StatusBadRequest))
+	assert.NotErrorIs(t, err, linodego.ErrWarmMigrationUnsupported)
+}
+
+func TestInstance_MigrateAndWait(t *testing.T) {
+	fixtures := NewTestFixtures()
+
+	fixtureData, err := fixtures.GetFixture("instance_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	base.MockEventFeed(map[string]any{
+		"id":     200,
+		"action": "linode_migrate_datacenter",
+		"entity": map[string]any{"id": 123, "type": "linode"},
+	}, "POST", "linode/instances/123/migrate", httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{}))
+	base.MockGet("linode/instances/123", fixtureData)
+
+	instance, err := base.Client.MigrateInstanceAndWait(context.Background(), 123, linodego.InstanceMigrateOptions{
+		Type:           linodego.WarmMigration,
+		Region:         "us-east",
+		PlacementGroup: &linodego.InstanceCreatePlacementGroupOptions{ID: 2468},
+	}, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 123, instance.ID)
+}
+
+func TestInstance_Get_MonthlyTransferInvalidMonth(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.GetInstanceTransferMonthly(context.Background(), 123, 2024, 13)
+	assert.EqualError(t, err, "invalid month 13: must be between 1 and 12")
+
+	_, err = client.GetInstanceTransferMonthlyV2(context.Background(), 123, 2024, 0)
+	assert.EqualError(t, err, "invalid month 0: must be between 1 and 12")
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestInstance_ResizeChecked(t *testing.T) {
+	fixtures := NewTestFixtures()
+
+	fixtureData, err := fixtures.GetFixture("instance_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances/123", fixtureData)
+	base.MockGet("linode/types/g6-standard-2", map[string]any{"id": "g6-standard-2"})
+	base.MockGet("linode/types/g1-gpu-rtx6000-1", map[string]any{"id": "g1-gpu-rtx6000-1"})
+	base.MockGet("regions/availability", map[string]any{
+		"page": 1, "pages": 1, "results": 2,
+		"data": []any{
+			map[string]any{"region": "us-east", "plan": "g6-standard-2", "available": true},
+			map[string]any{"region": "us-east", "plan": "g1-gpu-rtx6000-1", "available": false},
+		},
+	})
+	base.MockPost("linode/instances/123/resize", map[string]any{})
+
+	instance, err := base.Client.ResizeInstanceChecked(context.Background(), 123, linodego.InstanceResizeOptions{
+		Type: "g6-standard-2",
+	}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 123, instance.ID)
+
+	_, err = base.Client.ResizeInstanceChecked(context.Background(), 123, linodego.InstanceResizeOptions{
+		Type: "g1-gpu-rtx6000-1",
+	}, 0)
+	assert.EqualError(t, err, `linode type "g1-gpu-rtx6000-1" is not available in region us-east`)
+
+	// A plan without an availability entry for the region is treated as unavailable
+	base.MockGet("linode/types/g6-standard-4", map[string]any{"id": "g6-standard-4"})
+
+	_, err = base.Client.ResizeInstanceChecked(context.Background(), 123, linodego.InstanceResizeOptions{
+		Type: "g6-standard-4",
+	}, 0)
+	assert.EqualError(t, err, `linode type "g6-standard-4" is not available in region us-east`)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/types/g6-missing"),
+		httpmock.NewJsonResponderOrPanic(http.StatusNotFound, map[string]any{
+			"errors": []map[string]string{{"reason": "Not found"}},
+		}))
+
+	_, err = base.Client.ResizeInstanceChecked(context.Background(), 123, linodego.InstanceResizeOptions{
+		Type: "g6-missing",
+	}, 0)
+	assert.EqualError(t, err, `linode type "g6-missing" does not exist`)
+
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"linode/instances/123/resize"])
+}
+
+func TestInstance_NewRescueDeviceMap(t *testing.T) {
+	devices, err := linodego.NewRescueDeviceMap(11, 12)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, devices.SDA.DiskID)
+	assert.Equal(t, 12, devices.SDB.DiskID)
+	assert.Nil(t, devices.SDC)
+
+	_, err = linodego.NewRescueDeviceMap(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	assert.EqualError(t, err, "at most 8 devices may be mapped in rescue mode, got 9")
+}
+
+func TestInstance_RescueWithDisks(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances/123/disks", map[string]any{
+		"page": 1, "pages": 1, "results": 2,
+		"data": []any{
+			map[string]any{"id": 11, "filesystem": "ext4"},
+			map[string]any{"id": 12, "filesystem": "swap"},
+		},
+	})
+
+	requestData := linodego.InstanceRescueOptions{
+		Devices: linodego.InstanceConfigDeviceMap{SDA: &linodego.InstanceConfigDevice{DiskID: 11}},
+	}
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	base.MockEventFeed(map[string]any{
+		"id":     500,
+		"action": "linode_rescue",
+		"entity": map[string]any{"id": 123, "type": "linode"},
+	}, "POST", "linode/instances/123/rescue", mockRequestBodyValidate(t, requestData, nil))
+
+	event, err := base.Client.RescueInstanceWithDisks(context.Background(), 123, 30, 11)
+	assert.NoError(t, err)
+	assert.Equal(t, 500, event.ID)
+	assert.Equal(t, linodego.ActionLinodeRescue, event.Action)
+	assert.Equal(t, linodego.EventStarted, event.Status)
+
+	_, err = base.Client.RescueInstanceWithDisks(context.Background(), 123, 30, 11, 12)
+	assert.EqualError(t, err, "disk 12 is a swap disk and cannot be mapped in rescue mode")
+
+	_, err = base.Client.RescueInstanceWithDisks(context.Background(), 123, 30, 99)
+	assert.EqualError(t, err, "disk 99 does not belong to instance 123")
+}
+
 func TestInstance_ResetPassword(t *testing.T) {
 	client := createMockClient(t)
 
@@ -193,7 +377,7 @@ func TestInstance_Update(t *testing.T) {
 	defer base.TearDown(t)
 
 	updateOptions := linodego.InstanceUpdateOptions{
-		Label: "updated-instance",
+		Label: linodego.NullOf("updated-instance"),
 	}
 
 	base.MockPut("linode/instances/123", fixtureData)
@@ -303,3 +487,425 @@ func TestInstance_Rebuild(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "linode/ubuntu22.04", instance.Image)
 }
+
+func TestInstances_Iterator(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	pages := map[string]map[string]any{
+		"1": {
+			"page": 1, "pages": 2, "results": 3,
+			"data": []map[string]any{{"id": 1}, {"id": 2}},
+		},
+		"2": {
+			"page": 2, "pages": 2, "results": 3,
+			"data": []map[string]any{{"id": 3}},
+		},
+	}
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/instances",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "2", req.URL.Query().Get("page_size"))
+			return httpmock.NewJsonResponse(http.StatusOK, pages[req.URL.Query().Get("page")])
+		})
+
+	iter := linodego.NewInstancesIterator(base.Client, &linodego.ListOptions{PageSize: 2})
+
+	var ids []int
+	for iter.Next(context.Background()) {
+		ids = append(ids, iter.Instance().ID)
+	}
+
+	assert.NoError(t, iter.Err())
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	assert.False(t, iter.Next(context.Background()))
+}
+
+func TestInstances_IteratorError(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/instances",
+		httpmock.NewJsonResponderOrPanic(http.StatusNotFound, map[string]any{
+			"errors": []map[string]string{{"reason": "Not found"}},
+		}))
+
+	iter := linodego.NewInstancesIterator(base.Client, nil)
+
+	assert.False(t, iter.Next(context.Background()))
+	assert.True(t, linodego.IsNotFound(iter.Err()))
+}
+
+func TestInstances_Stream(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	pages := map[string]map[string]any{
+		"1": {
+			"page": 1, "pages": 2, "results": 3,
+			"data": []map[string]any{{"id": 1}, {"id": 2}},
+		},
+		"2": {
+			"page": 2, "pages": 2, "results": 3,
+			"data": []map[string]any{{"id": 3}},
+		},
+	}
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/instances",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, pages[req.URL.Query().Get("page")])
+		})
+
+	results, errs := linodego.StreamInstances(context.Background(), base.Client, nil)
+
+	var ids []int
+	for instance := range results {
+		ids = append(ids, instance.ID)
+	}
+
+	assert.NoError(t, <-errs)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestInstances_StreamCancel(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/instances",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{
+			"page": 1, "pages": 1, "results": 2,
+			"data": []map[string]any{{"id": 1}, {"id": 2}},
+		}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	results, errs := linodego.StreamInstances(ctx, base.Client, nil)
+
+	first := <-results
+	assert.Equal(t, 1, first.ID)
+
+	cancel()
+
+	assert.ErrorIs(t, <-errs, context.Canceled)
+
+	_, ok := <-results
+	assert.False(t, ok, "expected results channel to be closed")
+}
+
+func TestInstances_StreamConcurrentPages(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetStreamConcurrency(3)
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/instances",
+		func(req *http.Request) (*http.Response, error) {
+			page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+
+			mu.Lock()
+			inFlight++
+			maxInFlight = max(maxInFlight, inFlight)
+			mu.Unlock()
+
+			// Earlier pages respond more slowly so they complete out of order
+			time.Sleep(time.Duration(10-page) * 5 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"page": page, "pages": 8, "results": 8,
+				"data": []map[string]any{{"id": page}},
+			})
+		})
+
+	results, errs := linodego.StreamInstances(context.Background(), base.Client, nil)
+
+	var ids []int
+	for instance := range results {
+		ids = append(ids, instance.ID)
+	}
+
+	assert.NoError(t, <-errs)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, ids)
+	assert.Greater(t, maxInFlight, 1, "expected pages to be requested concurrently")
+	assert.LessOrEqual(t, maxInFlight, 3)
+}
+
+func TestInstances_StreamCancelDuringRequest(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/instances",
+		func(req *http.Request) (*http.Response, error) {
+			cancel()
+			<-req.Context().Done()
+
+			return nil, req.Context().Err()
+		})
+
+	results, errs := linodego.StreamInstances(ctx, base.Client, nil)
+
+	_, ok := <-results
+	assert.False(t, ok, "expected results channel to be closed")
+
+	err := <-errs
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestInstance_WaitForStatusWithOptions(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	statuses := []string{"booting", "booting", "running"}
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/instances/123",
+		func(req *http.Request) (*http.Response, error) {
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": 123, "status": status})
+		})
+
+	instance, err := base.Client.WaitForInstanceStatusWithOptions(
+		context.Background(), 123, linodego.InstanceRunning,
+		linodego.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, linodego.InstanceRunning, instance.Status)
+}
+
+func TestInstance_WaitForStatusWithOptionsTimeout(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances/123", map[string]any{"id": 123, "status": "booting"})
+
+	_, err := base.Client.WaitForInstanceStatusWithOptions(
+		context.Background(), 123, linodego.InstanceRunning,
+		linodego.WaitOptions{PollInterval: time.Millisecond, Timeout: 50 * time.Millisecond},
+	)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), `last observed status: "booting"`)
+}
+
+func TestInstance_CloneAndWait(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	base.MockEventFeed(map[string]any{
+		"id":               400,
+		"action":           "linode_clone",
+		"entity":           map[string]any{"id": 123, "type": "linode"},
+		"secondary_entity": map[string]any{"id": 456, "type": "linode"},
+	}, "POST", "linode/instances/123/clone", httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{"id": 456, "status": "provisioning"}))
+	base.MockGet("linode/instances/456", map[string]any{"id": 456, "status": "offline"})
+
+	instance, err := base.Client.CloneInstanceAndWait(context.Background(), 123, linodego.InstanceCloneOptions{
+		LinodeID: 456,
+		Disks:    []int{789},
+	}, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 456, instance.ID)
+	assert.Equal(t, linodego.InstanceOffline, instance.Status)
+}
+
+func TestInstance_CloneAndWaitInvalidTarget(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.CloneInstanceAndWait(context.Background(), 123, linodego.InstanceCloneOptions{
+		LinodeID: 456,
+		Region:   "us-east",
+	}, 5)
+	assert.EqualError(t, err, "region and type cannot be specified when cloning into existing instance 456")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestInstance_PowerActionsAndWait(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		action string
+		status linodego.InstanceStatus
+		run    func(c *linodego.Client) (*linodego.Instance, error)
+	}{
+		{
+			name: "boot", path: "boot", action: "linode_boot", status: linodego.InstanceRunning,
+			run: func(c *linodego.Client) (*linodego.Instance, error) {
+				return c.BootInstanceAndWait(context.Background(), 123, 0, 5)
+			},
+		},
+		{
+			name: "reboot", path: "reboot", action: "linode_reboot", status: linodego.InstanceRunning,
+			run: func(c *linodego.Client) (*linodego.Instance, error) {
+				return c.RebootInstanceAndWait(context.Background(), 123, 0, 5)
+			},
+		},
+		{
+			name: "shutdown", path: "shutdown", action: "linode_shutdown", status: linodego.InstanceOffline,
+			run: func(c *linodego.Client) (*linodego.Instance, error) {
+				return c.ShutdownInstanceAndWait(context.Background(), 123, 5)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var base ClientBaseCase
+			base.SetUp(t)
+			defer base.TearDown(t)
+
+			base.Client.SetPollDelay(time.Millisecond)
+
+			base.MockEventFeed(map[string]any{
+				"id":     400,
+				"action": tc.action,
+				"entity": map[string]any{"id": 123, "type": "linode"},
+			}, "POST", "linode/instances/123/"+tc.path, httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{}))
+			base.MockGet("linode/instances/123", map[string]any{"id": 123, "status": tc.status})
+
+			instance, err := tc.run(base.Client)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.status, instance.Status)
+		})
+	}
+}
+
+func TestInstanceCreateOptions_Validate(t *testing.T) {
+	valid := linodego.InstanceCreateOptions{
+		Region:   "us-east",
+		Type:     "g6-standard-1",
+		Image:    "linode/debian12",
+		RootPass: "correct-horse-battery-staple",
+		Interfaces: []linodego.InstanceConfigInterfaceCreateOptions{
+			{Purpose: linodego.InterfacePurposePublic},
+			{Purpose: linodego.InterfacePurposeVPC, SubnetID: linodego.Pointer(123)},
+		},
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalid := linodego.InstanceCreateOptions{
+		Image:          "linode/debian12",
+		BackupID:       456,
+		DiskEncryption: "on",
+		Interfaces: []linodego.InstanceConfigInterfaceCreateOptions{
+			{Purpose: linodego.InterfacePurposeVLAN},
+			{Purpose: "private"},
+		},
+	}
+	assert.EqualError(t, invalid.Validate(), `region is required
+type is required
+image and backup_id cannot both be set
+unknown disk_encryption "on"
+interface 0: label is required for vlan interfaces
+interface 1: unknown purpose "private"`)
+}
+
+func TestInstanceCreateOptions_Clone(t *testing.T) {
+	base := linodego.InstanceCreateOptions{
+		Region:          "us-east",
+		Type:            "g6-standard-1",
+		AuthorizedKeys:  []string{"ssh-ed25519 AAAA"},
+		StackScriptData: map[string]string{"hostname": "base"},
+		Tags:            []string{"base"},
+		Interfaces: []linodego.InstanceConfigInterfaceCreateOptions{
+			{Purpose: linodego.InterfacePurposeVPC, SubnetID: linodego.Pointer(1), IPRanges: []string{"10.0.0.0/24"}},
+		},
+		PlacementGroup: &linodego.InstanceCreatePlacementGroupOptions{ID: 1, CompliantOnly: linodego.Pointer(true)},
+		Booted:         linodego.Pointer(true),
+	}
+
+	clone := base.Clone()
+	assert.Equal(t, base, clone)
+
+	clone.AuthorizedKeys[0] = "changed"
+	clone.StackScriptData["hostname"] = "changed"
+	clone.Tags[0] = "changed"
+	*clone.Interfaces[0].SubnetID = 2
+	clone.Interfaces[0].IPRanges[0] = "changed"
+	*clone.PlacementGroup.CompliantOnly = false
+	*clone.Booted = false
+
+	assert.Equal(t, "ssh-ed25519 AAAA", base.AuthorizedKeys[0])
+	assert.Equal(t, "base", base.StackScriptData["hostname"])
+	assert.Equal(t, "base", base.Tags[0])
+	assert.Equal(t, 1, *base.Interfaces[0].SubnetID)
+	assert.Equal(t, "10.0.0.0/24", base.Interfaces[0].IPRanges[0])
+	assert.True(t, *base.PlacementGroup.CompliantOnly)
+	assert.True(t, *base.Booted)
+}
+
+func TestInstance_UpdateTags(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("instance_update")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	var body map[string]json.RawMessage
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "linode/instances/123"),
+		func(req *http.Request) (*http.Response, error) {
+			body = nil
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, fixtureData)
+		})
+
+	_, err = base.Client.UpdateInstance(context.Background(), 123, linodego.InstanceUpdateOptions{
+		Tags: &[]string{},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[]`, string(body["tags"]))
+
+	_, err = base.Client.UpdateInstance(context.Background(), 123, linodego.InstanceUpdateOptions{
+		Label: linodego.NullOf("updated-instance"),
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, body, "tags")
+
+	// An empty label is sent rather than omitted
+	_, err = base.Client.UpdateInstance(context.Background(), 123, linodego.InstanceUpdateOptions{
+		Label: linodego.NullOf(""),
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `""`, string(body["label"]))
+}
+
+func TestInstance_SetBackupsEnabled(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockPost("linode/instances/123/backups/enable", nil)
+	base.MockPost("linode/instances/123/backups/cancel", nil)
+
+	assert.NoError(t, base.Client.SetInstanceBackupsEnabled(context.Background(), 123, false))
+
+	calls := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, calls["POST "+base.BaseURL+"linode/instances/123/backups/cancel"])
+	assert.Equal(t, 0, calls["POST "+base.BaseURL+"linode/instances/123/backups/enable"])
+
+	assert.NoError(t, base.Client.SetInstanceBackupsEnabled(context.Background(), 123, true))
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"linode/instances/123/backups/enable"])
+}
@@ -0,0 +1,79 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKernels_FilterKernels(t *testing.T) {
+	kernels := []linodego.LinodeKernel{
+		{ID: "linode/5.4.0", Architecture: "x86_64"},
+		{ID: "linode/4.19.0", Architecture: "x86_64", Deprecated: true},
+		{ID: "linode/5.4.0-i386", Architecture: "i386"},
+	}
+
+	result := linodego.FilterKernels(kernels, func(k linodego.LinodeKernel) bool {
+		return k.Architecture == "x86_64" && !k.Deprecated
+	})
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, "linode/5.4.0", result[0].ID)
+}
+
+func TestKernels_KernelFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   linodego.KernelFilter
+		expected string
+	}{
+		{"empty", linodego.KernelFilter{}, `{}`},
+		{"architecture", linodego.KernelFilter{Architecture: "i386"}, `{"architecture": "i386"}`},
+		{
+			"all",
+			linodego.KernelFilter{KVM: linodego.Pointer(true), Deprecated: linodego.Pointer(true), Architecture: "x86_64"},
+			`{"architecture": "x86_64", "deprecated": true, "kvm": true}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := tc.filter.Build()
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expected, f)
+		})
+	}
+}
+
+func TestKernels_GetLatest(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"linode/kernels",
+		func(req *http.Request) (*http.Response, error) {
+			assert.JSONEq(t,
+				`{"architecture": "x86_64", "deprecated": false, "kvm": true}`,
+				req.Header.Get("X-Filter"),
+			)
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data": []any{
+					map[string]any{"id": "linode/6.1.0", "architecture": "x86_64", "built": "2023-01-01T00:00:00"},
+					map[string]any{"id": "linode/6.8.0", "architecture": "x86_64", "built": "2024-06-01T00:00:00"},
+					map[string]any{"id": "linode/5.4.0", "architecture": "x86_64", "built": "2020-03-01T00:00:00"},
+				},
+				"page":    1,
+				"pages":   1,
+				"results": 3,
+			})
+		})
+
+	kernel, err := base.Client.GetLatestKernel(context.Background(), "x86_64")
+	assert.NoError(t, err)
+	assert.Equal(t, "linode/6.8.0", kernel.ID)
+}
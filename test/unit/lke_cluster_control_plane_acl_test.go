@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -69,3 +70,34 @@ func TestLKEClusterControlPlaneACL_Delete(t *testing.T) {
 	err := base.Client.DeleteLKEClusterControlPlaneACL(context.Background(), 123)
 	assert.NoError(t, err)
 }
+
+func TestLKEClusterControlPlaneACL_UpdateInvalidAddress(t *testing.T) {
+	client := createMockClient(t)
+
+	tests := []struct {
+		addresses linodego.LKEClusterControlPlaneACLAddressesOptions
+		err       string
+	}{
+		{
+			linodego.LKEClusterControlPlaneACLAddressesOptions{IPv4: &[]string{"10.0.0.300/32"}},
+			`invalid IPv4 address "10.0.0.300/32": must be an IP address or CIDR range`,
+		},
+		{
+			linodego.LKEClusterControlPlaneACLAddressesOptions{IPv4: &[]string{"2001:db8::/64"}},
+			`invalid IPv4 address "2001:db8::/64": address is not IPv4`,
+		},
+		{
+			linodego.LKEClusterControlPlaneACLAddressesOptions{IPv6: &[]string{"10.0.0.1"}},
+			`invalid IPv6 address "10.0.0.1": address is not IPv6`,
+		},
+	}
+
+	for _, tc := range tests {
+		_, err := client.UpdateLKEClusterControlPlaneACL(context.Background(), 123, linodego.LKEClusterControlPlaneACLUpdateOptions{
+			ACL: linodego.LKEClusterControlPlaneACLOptions{Addresses: &tc.addresses},
+		})
+		assert.EqualError(t, err, tc.err)
+	}
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -204,3 +204,199 @@ func TestLKECluster_GetAPLHealthCheckURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "https://auth.lke123.akamai-apl.net/ready", url)
 }
+
+func TestLKECluster_UpgradeVersion(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("lke/clusters/123", map[string]any{"id": 123, "k8s_version": "1.30"})
+	base.MockGet("lke/versions", map[string]any{
+		"data":    []map[string]any{{"id": "1.30"}, {"id": "1.31"}, {"id": "1.32"}},
+		"pages":   1,
+		"results": 3,
+	})
+	base.MockPut("lke/clusters/123", map[string]any{"id": 123, "k8s_version": "1.31"})
+	base.MockGet("lke/clusters/123/pools", map[string]any{"data": []map[string]any{}, "pages": 1, "results": 0})
+	base.MockPost("lke/clusters/123/recycle", map[string]any{})
+
+	cluster, err := base.Client.UpgradeLKEClusterVersion(context.Background(), 123, "1.31", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.31", cluster.K8sVersion)
+
+	calls := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, calls["PUT "+base.BaseURL+"lke/clusters/123"])
+	assert.Equal(t, 1, calls["POST "+base.BaseURL+"lke/clusters/123/recycle"])
+}
+
+func TestLKECluster_UpgradeVersionNotAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		message string
+	}{
+		{"skipped minor", "1.32", "minor versions must be upgraded one at a time (next version is 1.31)"},
+		{"unavailable", "1.33", "version 1.33 is not available"},
+		{"already running", "1.30", "cluster is already running 1.30"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var base ClientBaseCase
+			base.SetUp(t)
+			defer base.TearDown(t)
+
+			base.MockGet("lke/clusters/123", map[string]any{"id": 123, "k8s_version": "1.30"})
+			base.MockGet("lke/versions", map[string]any{
+				"data":    []map[string]any{{"id": "1.30"}, {"id": "1.31"}, {"id": "1.32"}},
+				"pages":   1,
+				"results": 3,
+			})
+
+			_, err := base.Client.UpgradeLKEClusterVersion(context.Background(), 123, tc.target, true)
+			assert.ErrorIs(t, err, linodego.ErrLKEUpgradeNotAllowed)
+			assert.ErrorContains(t, err, tc.message)
+			assert.Equal(t, 2, httpmock.GetTotalCallCount())
+		})
+	}
+}
+
+func TestLKECluster_UpgradeVersionPatchRelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		message string
+	}{
+		{"patch only", "v1.31.9+lke1", ""},
+		{"release only", "v1.31.8+lke2", ""},
+		{"patch and release", "v1.31.9+lke2", ""},
+		{"next minor", "v1.32.1+lke1", ""},
+		{"older patch", "v1.31.7+lke3", "cannot upgrade from v1.31.8+lke1 to v1.31.7+lke3"},
+		{"already running", "v1.31.8+lke1", "cluster is already running v1.31.8+lke1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var base ClientBaseCase
+			base.SetUp(t)
+			defer base.TearDown(t)
+
+			base.MockGet("lke/clusters/123", map[string]any{"id": 123, "k8s_version": "v1.31.8+lke1", "tier": "enterprise"})
+			base.MockGet("lke/tiers/enterprise/versions", map[string]any{
+				"data": []map[string]any{
+					{"id": "v1.31.7+lke3"}, {"id": "v1.31.8+lke1"}, {"id": "v1.31.8+lke2"},
+					{"id": "v1.31.9+lke1"}, {"id": "v1.31.9+lke2"}, {"id": "v1.32.1+lke1"},
+				},
+				"pages":   1,
+				"results": 6,
+			})
+			base.MockPut("lke/clusters/123", map[string]any{"id": 123, "k8s_version": tc.target, "tier": "enterprise"})
+
+			cluster, err := base.Client.UpgradeLKEClusterVersion(context.Background(), 123, tc.target, false)
+			if tc.message != "" {
+				assert.ErrorIs(t, err, linodego.ErrLKEUpgradeNotAllowed)
+				assert.ErrorContains(t, err, tc.message)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.target, cluster.K8sVersion)
+		})
+	}
+}
+
+func TestLKECluster_CreateEnterpriseOnlyOptions(t *testing.T) {
+	client := createMockClient(t)
+
+	createOptions := linodego.LKEClusterCreateOptions{
+		Label:      "new-cluster",
+		Region:     "us-west",
+		K8sVersion: "1.31",
+		NodePools: []linodego.LKENodePoolCreateOptions{
+			{Count: 3, Type: "g6-standard-2"},
+			{Count: 3, Type: "g6-standard-2", UpdateStrategy: Ptr(linodego.LKENodePoolOnRecycle)},
+		},
+	}
+
+	_, err := client.CreateLKECluster(context.Background(), createOptions)
+	assert.ErrorIs(t, err, linodego.ErrLKEEnterpriseOnly)
+	assert.ErrorContains(t, err, "node pool 1 sets update_strategy")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestLKECluster_CreateEnterprise(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockPost("lke/clusters", map[string]any{"id": 125, "label": "new-cluster", "tier": "enterprise"})
+
+	cluster, err := base.Client.CreateLKECluster(context.Background(), linodego.LKEClusterCreateOptions{
+		Label:      "new-cluster",
+		Region:     "us-west",
+		K8sVersion: "v1.31.8+lke1",
+		Tier:       string(linodego.LKEVersionEnterprise),
+		NodePools: []linodego.LKENodePoolCreateOptions{
+			{Count: 3, Type: "g6-standard-2", UpdateStrategy: Ptr(linodego.LKENodePoolOnRecycle)},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "enterprise", cluster.Tier)
+	assert.Equal(t, "enterprise", cluster.GetCreateOptions().Tier)
+}
+
+func TestLKEClusterCreateOptions_Validate(t *testing.T) {
+	valid := linodego.LKEClusterCreateOptions{
+		Label:      "new-cluster",
+		Region:     "us-west",
+		K8sVersion: "1.31",
+		NodePools:  []linodego.LKENodePoolCreateOptions{{Count: 3, Type: "g6-standard-2"}},
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalid := linodego.LKEClusterCreateOptions{
+		Tier: "premium",
+		NodePools: []linodego.LKENodePoolCreateOptions{
+			{Count: 0, K8sVersion: Ptr("v1.31.8+lke1")},
+		},
+	}
+	assert.EqualError(t, invalid.Validate(), `label is required
+region is required
+k8s_version is required
+unknown tier "premium"
+node pool 0: type is required`)
+}
+
+func TestLKEClusterCreateOptions_Clone(t *testing.T) {
+	base := linodego.LKEClusterCreateOptions{
+		Label:      "new-cluster",
+		Region:     "us-west",
+		K8sVersion: "1.31",
+		Tags:       []string{"base"},
+		NodePools: []linodego.LKENodePoolCreateOptions{
+			{Count: 3, Type: "g6-standard-2", Labels: linodego.LKENodePoolLabels{"env": "base"}, Tags: []string{"base"}},
+		},
+		ControlPlane: &linodego.LKEClusterControlPlaneOptions{
+			HighAvailability: linodego.Pointer(true),
+			ACL: &linodego.LKEClusterControlPlaneACLOptions{
+				Addresses: &linodego.LKEClusterControlPlaneACLAddressesOptions{IPv4: &[]string{"10.0.0.1/32"}},
+			},
+		},
+	}
+
+	clone := base.Clone()
+	assert.Equal(t, base, clone)
+
+	clone.Tags[0] = "changed"
+	clone.NodePools[0].Labels["env"] = "changed"
+	clone.NodePools[0].Tags[0] = "changed"
+	*clone.ControlPlane.HighAvailability = false
+	(*clone.ControlPlane.ACL.Addresses.IPv4)[0] = "changed"
+
+	assert.Equal(t, "base", base.Tags[0])
+	assert.Equal(t, "base", base.NodePools[0].Labels["env"])
+	assert.Equal(t, "base", base.NodePools[0].Tags[0])
+	assert.True(t, *base.ControlPlane.HighAvailability)
+	assert.Equal(t, "10.0.0.1/32", (*base.ControlPlane.ACL.Addresses.IPv4)[0])
+}
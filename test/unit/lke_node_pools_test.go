@@ -2,9 +2,11 @@ package unit
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/linode/linodego"
+	"github.com/linode/linodego/internal/testutil"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/jarcoal/httpmock"
@@ -15,13 +17,19 @@ func Ptr[T any](v T) *T {
 }
 
 func TestLKENodePool_Recycle(t *testing.T) {
-	client := createMockClient(t)
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
 
-	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "clusters/1234/pools/12345/recycle"), httpmock.NewStringResponder(200, "{}"))
+	base.MockGet("lke/clusters/1234/pools/12345", map[string]any{
+		"id":    12345,
+		"nodes": []map[string]any{{"id": "12345-a"}, {"id": "12345-b"}},
+	})
+	base.MockPost("lke/clusters/1234/pools/12345/recycle", map[string]any{})
 
-	if err := client.RecycleLKENodePool(context.Background(), 1234, 12345); err != nil {
-		t.Fatal(err)
-	}
+	ids, err := base.Client.RecycleLKENodePool(context.Background(), 1234, 12345)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"12345-a", "12345-b"}, ids)
 }
 
 func TestLKENodePoolNode_Recycle(t *testing.T) {
@@ -225,3 +233,91 @@ func TestLKEEnterpriseNodePool_Update(t *testing.T) {
 	assert.Equal(t, "v1.31.1+lke1", *nodePool.K8sVersion)
 	assert.Equal(t, "rolling_update", string(*nodePool.UpdateStrategy))
 }
+
+func TestLKENodePool_UpdateAutoscaler(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("lke_node_pool_update")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("PUT", base.BaseURL+"lke/clusters/123/pools/456",
+		testutil.MockRequestBodyValidate(t, map[string]any{
+			"autoscaler": map[string]any{"enabled": true, "min": float64(2), "max": float64(8)},
+		}, fixtureData))
+
+	nodePool, err := base.Client.UpdateLKENodePoolAutoscaler(context.Background(), 123, 456, linodego.LKENodePoolAutoscalerUpdateOptions{
+		Enabled: true,
+		Min:     Ptr(2),
+		Max:     Ptr(8),
+	})
+	assert.NoError(t, err)
+	assert.True(t, nodePool.Autoscaler.Enabled)
+	assert.Equal(t, 2, nodePool.Autoscaler.Min)
+	assert.Equal(t, 8, nodePool.Autoscaler.Max)
+}
+
+func TestLKENodePool_DisableAutoscaler(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("lke_node_pool_update")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("PUT", base.BaseURL+"lke/clusters/123/pools/456",
+		testutil.MockRequestBodyValidate(t, map[string]any{
+			"autoscaler": map[string]any{"enabled": false},
+		}, fixtureData))
+
+	_, err = base.Client.UpdateLKENodePoolAutoscaler(context.Background(), 123, 456, linodego.LKENodePoolAutoscalerUpdateOptions{
+		Enabled: false,
+	})
+	assert.NoError(t, err)
+}
+
+func TestLKEClusterNodeIDs_List(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("lke/clusters/123/pools", map[string]any{
+		"data": []map[string]any{
+			{"id": 456, "nodes": []map[string]any{{"id": "456-a"}, {"id": "456-b"}}},
+			{"id": 789, "nodes": []map[string]any{{"id": "789-a"}}},
+		},
+		"pages":   1,
+		"results": 2,
+	})
+
+	ids, err := base.Client.ListLKEClusterNodeIDs(context.Background(), 123)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"456-a", "456-b", "789-a"}, ids)
+}
+
+func TestLKECluster_RecycleNodes(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("lke/clusters/123/pools", map[string]any{
+		"data": []map[string]any{
+			{"id": 456, "nodes": []map[string]any{{"id": "456-a"}}},
+			{"id": 789, "nodes": []map[string]any{{"id": "789-a"}}},
+		},
+		"pages":   1,
+		"results": 2,
+	})
+	base.MockPost("lke/clusters/123/recycle", map[string]any{})
+
+	ids, err := base.Client.RecycleLKEClusterNodes(context.Background(), 123)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"456-a", "789-a"}, ids)
+}
+
+func TestLKENodePoolAutoscalerUpdateOptions_MarshalDisabled(t *testing.T) {
+	data, err := json.Marshal(linodego.LKENodePoolAutoscalerUpdateOptions{Enabled: false})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"enabled":false}`, string(data))
+}
@@ -2,9 +2,11 @@ package unit
 
 import (
 	"context"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -109,3 +111,38 @@ func TestGetLongviewPlan(t *testing.T) {
 	assert.Equal(t, 50.00, plan.Price.Hourly, "Expected hourly price to match")
 	assert.Equal(t, 500.00, plan.Price.Monthly, "Expected monthly price to match")
 }
+
+func TestUpdateLongviewPlan(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("longview_plan")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockPut("longview/plan", fixtureData)
+
+	plan, err := base.Client.UpdateLongviewPlan(context.Background(), linodego.LongviewPlanUpdateOptions{
+		LongviewSubscription: "longview-plan-id",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "longview-plan-id", plan.ID)
+	assert.Equal(t, 500.00, plan.Price.Monthly)
+}
+
+func TestUpdateLongviewPlan_Forbidden(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "longview/plan"),
+		httpmock.NewJsonResponderOrPanic(http.StatusForbidden, map[string]any{
+			"errors": []map[string]string{{"reason": "You must have a valid payment method to upgrade your Longview plan."}},
+		}))
+
+	_, err := base.Client.UpdateLongviewPlan(context.Background(), linodego.LongviewPlanUpdateOptions{
+		LongviewSubscription: "longview-10",
+	})
+	assert.True(t, linodego.ErrHasStatus(err, http.StatusForbidden))
+	assert.ErrorContains(t, err, "valid payment method")
+}
@@ -0,0 +1,121 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManagedServices_List(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("managed_services_list")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("managed/services", fixtureData)
+
+	services, err := base.Client.ListManagedServices(context.Background(), nil)
+	assert.NoError(t, err)
+
+	assert.Len(t, services, 1)
+	assert.Equal(t, 9944, services[0].ID)
+	assert.Equal(t, linodego.ManagedServiceTypeURL, services[0].ServiceType)
+	assert.Equal(t, linodego.ManagedServiceStatusOK, services[0].Status)
+	assert.Equal(t, []int{9991}, services[0].Credentials)
+	assert.Equal(t, "2018-01-01 00:01:01 +0000 UTC", services[0].Created.String())
+}
+
+func TestManagedService_Get(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("managed_service_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("managed/services/9944", fixtureData)
+
+	service, err := base.Client.GetManagedService(context.Background(), 9944)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "prod-1", service.Label)
+	assert.Equal(t, "https://example.org", service.Address)
+	assert.Equal(t, 30, service.Timeout)
+	assert.Equal(t, "on-call", service.ConsultationGroup)
+	assert.Nil(t, service.Region)
+}
+
+func TestManagedService_Create(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("managed_service_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	requestData := linodego.ManagedServiceCreateOptions{
+		Label:             "prod-1",
+		ServiceType:       linodego.ManagedServiceTypeURL,
+		Address:           "https://example.org",
+		Timeout:           30,
+		Body:              "it worked",
+		ConsultationGroup: "on-call",
+		Credentials:       []int{9991},
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "managed/services"),
+		mockRequestBodyValidate(t, requestData, fixtureData))
+
+	service, err := base.Client.CreateManagedService(context.Background(), requestData)
+	assert.NoError(t, err)
+	assert.Equal(t, 9944, service.ID)
+}
+
+func TestManagedService_Update(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("managed_service_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	requestData := linodego.ManagedServiceUpdateOptions{
+		Label:       "prod-1",
+		ServiceType: linodego.ManagedServiceTypeTCP,
+		Timeout:     10,
+	}
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "managed/services/9944"),
+		mockRequestBodyValidate(t, requestData, fixtureData))
+
+	service, err := base.Client.UpdateManagedService(context.Background(), 9944, requestData)
+	assert.NoError(t, err)
+	assert.Equal(t, "prod-1", service.Label)
+}
+
+func TestManagedService_GetUpdateOptions(t *testing.T) {
+	service := linodego.ManagedService{Label: "prod-1", ConsultationGroup: "on-call", Credentials: []int{9991}}
+
+	opts := service.GetUpdateOptions()
+	assert.Equal(t, "on-call", *opts.ConsultationGroup)
+	assert.Equal(t, []int{9991}, *opts.Credentials)
+
+	(*opts.Credentials)[0] = 1234
+	assert.Equal(t, []int{9991}, service.Credentials)
+}
+
+func TestManagedService_Delete(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockDelete("managed/services/9944", nil)
+
+	err := base.Client.DeleteManagedService(context.Background(), 9944)
+	assert.NoError(t, err)
+}
@@ -2,7 +2,11 @@ package unit
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 
@@ -212,3 +216,133 @@ func TestDatabaseMySQL_Resume(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDatabaseMySQL_CreateFork(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("mysql_database_create")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	restoreTime := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "databases/mysql/instances"),
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(body), `"fork":{"source":456,"restore_time":"2024-05-01T12:30:00"}`)
+			return httpmock.NewJsonResponse(http.StatusOK, fixtureData)
+		})
+
+	db, err := base.Client.CreateMySQLDatabaseFork(context.Background(), linodego.MySQLCreateOptions{
+		Label:  "example-db-created",
+		Region: "us-east",
+		Type:   "g6-dedicated-2",
+		Engine: "mysql",
+		Fork:   &linodego.DatabaseFork{Source: 456, RestoreTime: &restoreTime},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 123, db.ID)
+
+	_, err = base.Client.CreateMySQLDatabaseFork(context.Background(), linodego.MySQLCreateOptions{Label: "no-source"})
+	assert.Error(t, err)
+}
+
+func TestDatabaseMySQL_MaintenanceWindow(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("mysql_database_update")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("databases/mysql/instances/123", fixtureData)
+	base.MockPut("databases/mysql/instances/123", fixtureData)
+
+	window, err := base.Client.GetMySQLDatabaseMaintenanceWindow(context.Background(), 123)
+	assert.NoError(t, err)
+	assert.Equal(t, linodego.DatabaseMaintenanceDayMonday, window.DayOfWeek)
+	assert.Equal(t, 3, window.Duration)
+
+	window, err = base.Client.UpdateMySQLDatabaseMaintenanceWindow(context.Background(), 123, linodego.DatabaseMaintenanceWindow{
+		DayOfWeek: linodego.DatabaseMaintenanceDayMonday,
+		Duration:  3,
+		Frequency: linodego.DatabaseMaintenanceFrequencyWeekly,
+		HourOfDay: 0,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, linodego.DatabaseMaintenanceFrequencyWeekly, window.Frequency)
+}
+
+func TestDatabaseMySQL_MaintenanceWindowInvalid(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.UpdateMySQLDatabaseMaintenanceWindow(context.Background(), 123, linodego.DatabaseMaintenanceWindow{
+		DayOfWeek: 0,
+		Duration:  3,
+		Frequency: linodego.DatabaseMaintenanceFrequencyWeekly,
+	})
+	assert.EqualError(t, err, "invalid maintenance window day_of_week 0: must be between 1 (Monday) and 7 (Sunday)")
+
+	_, err = client.UpdateMySQLDatabaseMaintenanceWindow(context.Background(), 123, linodego.DatabaseMaintenanceWindow{
+		DayOfWeek: linodego.DatabaseMaintenanceDaySunday,
+		HourOfDay: 24,
+	})
+	assert.EqualError(t, err, "invalid maintenance window hour_of_day 24: must be between 0 and 23")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestDatabaseMySQL_AllowList(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("mysql_database_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("databases/mysql/instances/123", fixtureData)
+
+	var sent []string
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, "databases/mysql/instances/123"),
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				AllowList []string `json:"allow_list"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			sent = body.AllowList
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": 123, "allow_list": body.AllowList})
+		})
+
+	allowList, err := base.Client.AddMySQLDatabaseAllowedIP(context.Background(), 123, "198.51.100.0/24")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"203.0.113.1/32", "192.0.1.0/24", "198.51.100.0/24"}, sent)
+	assert.Equal(t, sent, allowList)
+
+	allowList, err = base.Client.RemoveMySQLDatabaseAllowedIP(context.Background(), 123, "192.0.1.0/24")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"203.0.113.1/32"}, allowList)
+
+	_, err = base.Client.AddMySQLDatabaseAllowedIP(context.Background(), 123, "203.0.113.1/32")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["PUT "+base.BaseURL+"databases/mysql/instances/123"])
+
+	allowList, err = base.Client.UpdateMySQLDatabaseAllowList(context.Background(), 123, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, sent)
+	assert.Empty(t, allowList)
+}
+
+func TestDatabaseMySQL_AllowListInvalid(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.AddMySQLDatabaseAllowedIP(context.Background(), 123, "203.0.113.1/33")
+	assert.EqualError(t, err, `invalid allow list entry "203.0.113.1/33": must be an IP address or CIDR range`)
+
+	_, err = client.UpdateMySQLDatabaseAllowList(context.Background(), 123, []string{"2001:db8::/64", "example.com"})
+	assert.EqualError(t, err, `invalid allow list entry "example.com": must be an IP address or CIDR range`)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -2,7 +2,11 @@ package unit
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
 
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
@@ -86,3 +90,73 @@ func TestIPShareAddresses(t *testing.T) {
 	})
 	assert.NoError(t, err, "Expected no error when sharing IP addresses")
 }
+
+func TestIPShareAddressesChecked(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances/123", map[string]any{"id": 123, "region": "us-east"})
+	base.MockGet("networking/ips/192.0.2.1", map[string]any{"address": "192.0.2.1", "region": "us-east"})
+	base.MockGet("networking/ips/198.51.100.1", map[string]any{"address": "198.51.100.1", "region": "us-west"})
+	base.MockPost("networking/ips/share", map[string]any{})
+	base.MockGet("linode/instances/123/ips", map[string]any{
+		"ipv4": map[string]any{
+			"shared": []any{map[string]any{"address": "192.0.2.1", "region": "us-east"}},
+		},
+	})
+
+	result, err := base.Client.ShareIPAddressesChecked(context.Background(), linodego.IPAddressesShareOptions{
+		LinodeID: 123,
+		IPs:      []string{"192.0.2.1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "192.0.2.1", result.IPv4.Shared[0].Address)
+
+	_, err = base.Client.ShareIPAddressesChecked(context.Background(), linodego.IPAddressesShareOptions{
+		LinodeID: 123,
+		IPs:      []string{"192.0.2.1", "198.51.100.1"},
+	})
+	assert.EqualError(t, err, "IP address 198.51.100.1 is in region us-west, but Linode 123 is in region us-east")
+}
+
+func TestIPUpdateAddressRDNS(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	attempts := 0
+
+	httpmock.RegisterResponder("PUT", base.BaseURL+"networking/ips/192.0.2.1",
+		func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts < 3 {
+				return httpmock.NewJsonResponse(http.StatusBadRequest, map[string]any{
+					"errors": []map[string]string{{"field": "rdns", "reason": "Domain does not resolve to this IP address"}},
+				})
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"address": "192.0.2.1", "rdns": "web.example.com"})
+		})
+
+	ip, err := base.Client.UpdateIPAddressRDNS(context.Background(), "192.0.2.1", "web.example.com",
+		linodego.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second})
+	assert.NoError(t, err)
+	assert.Equal(t, "web.example.com", ip.RDNS)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestIPUpdateAddressRDNS_OtherError(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("PUT", base.BaseURL+"networking/ips/192.0.2.1",
+		httpmock.NewJsonResponderOrPanic(http.StatusBadRequest, map[string]any{
+			"errors": []map[string]string{{"field": "rdns", "reason": "Invalid domain"}},
+		}))
+
+	_, err := base.Client.UpdateIPAddressRDNS(context.Background(), "192.0.2.1", "bad",
+		linodego.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second})
+	assert.ErrorContains(t, err, "Invalid domain")
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -98,6 +98,16 @@ func TestIPv6Range_Create(t *testing.T) {
 	assert.Equal(t, createOpts.RouteTarget, createdRange.RouteTarget, "Expected matching route target")
 }
 
+func TestIPv6Range_CreateInvalid(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.CreateIPv6Range(context.Background(), linodego.IPv6RangeCreateOptions{PrefixLength: 64})
+	assert.EqualError(t, err, "either a Linode ID or a route target must be provided")
+
+	_, err = client.CreateIPv6Range(context.Background(), linodego.IPv6RangeCreateOptions{LinodeID: 123, PrefixLength: 48})
+	assert.EqualError(t, err, "invalid prefix length 48: must be 56 or 64")
+}
+
 func TestIPv6Range_Delete(t *testing.T) {
 	var base ClientBaseCase
 	base.SetUp(t)
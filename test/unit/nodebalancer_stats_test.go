@@ -3,6 +3,7 @@ package unit
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -34,3 +35,29 @@ func TestNodeBalancerStats_Get(t *testing.T) {
 	assert.Equal(t, 1000.0, stats.Data.Traffic.In[0][0])
 	assert.Equal(t, 500.0, stats.Data.Traffic.Out[0][0])
 }
+
+func TestNodeBalancerStats_Points(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("nodebalancer_stats_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("nodebalancers/123/stats", fixtureData)
+
+	stats, err := base.Client.GetNodeBalancerStats(context.Background(), 123)
+	assert.NoError(t, err)
+
+	connections := stats.Data.ConnectionPoints()
+	assert.Len(t, connections, 2)
+	assert.Equal(t, time.UnixMilli(3000).UTC(), connections[1].Time)
+	assert.Equal(t, 4000.0, connections[1].Value)
+
+	out := stats.Data.Traffic.OutPoints()
+	assert.Len(t, out, 2)
+	assert.Equal(t, time.UnixMilli(500).UTC(), out[0].Time)
+	assert.Equal(t, 1000.0, out[0].Value)
+
+	assert.Len(t, stats.Data.Traffic.InPoints(), 2)
+}
@@ -2,8 +2,17 @@ package unit
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -38,9 +47,11 @@ func TestObjectStorageBucketCert_Upload(t *testing.T) {
 	clusterID := "us-east-1"
 	bucketName := "my-bucket"
 
+	cert, key := generateTestCertPair(t)
+
 	uploadOpts := linodego.ObjectStorageBucketCertUploadOptions{
-		Certificate: "mock-cert",
-		PrivateKey:  "mock-key",
+		Certificate: cert,
+		PrivateKey:  key,
 	}
 
 	base.MockPost("object-storage/buckets/"+clusterID+"/"+bucketName+"/ssl", fixtureData)
@@ -82,9 +93,11 @@ func TestObjectStorageBucketCertV2_Upload(t *testing.T) {
 	clusterID := "us-east-1"
 	bucketName := "my-bucket"
 
+	cert, key := generateTestCertPair(t)
+
 	uploadOpts := linodego.ObjectStorageBucketCertUploadOptions{
-		Certificate: "mock-cert",
-		PrivateKey:  "mock-key",
+		Certificate: cert,
+		PrivateKey:  key,
 	}
 
 	base.MockPost("object-storage/buckets/"+clusterID+"/"+bucketName+"/ssl", fixtureData)
@@ -109,3 +122,41 @@ func TestObjectStorageBucketCert_Delete(t *testing.T) {
 	err := base.Client.DeleteObjectStorageBucketCert(context.Background(), clusterID, bucketName)
 	assert.NoError(t, err)
 }
+
+func TestObjectStorageBucketCert_UploadMismatchedKey(t *testing.T) {
+	client := createMockClient(t)
+
+	cert, _ := generateTestCertPair(t)
+	_, otherKey := generateTestCertPair(t)
+
+	_, err := client.UploadObjectStorageBucketCertV2(context.Background(), "us-east-1", "my-bucket",
+		linodego.ObjectStorageBucketCertUploadOptions{
+			Certificate: cert,
+			PrivateKey:  otherKey,
+		})
+	assert.ErrorContains(t, err, "invalid certificate and private key pair")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func generateTestCertPair(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
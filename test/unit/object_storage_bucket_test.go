@@ -2,8 +2,10 @@ package unit
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -156,3 +158,25 @@ func TestObjectStorageBucket_ListContents(t *testing.T) {
 	assert.NotNil(t, contents)
 	assert.True(t, contents.IsTruncated)
 }
+
+func TestObjectStorageBucket_ListContentsParams(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("object_storage_bucket_contents")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponderWithQuery("GET", base.BaseURL+"object-storage/buckets/us-east-1/my-bucket/object-list",
+		"marker=next-object&delimiter=%2F&page_size=50",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, fixtureData))
+
+	contents, err := base.Client.ListObjectStorageBucketContents(context.Background(), "us-east-1", "my-bucket",
+		&linodego.ObjectStorageBucketListContentsParams{
+			Marker:    linodego.Pointer("next-object"),
+			Delimiter: linodego.Pointer("/"),
+			PageSize:  linodego.Pointer(50),
+		})
+	assert.NoError(t, err)
+	assert.True(t, contents.IsTruncated)
+}
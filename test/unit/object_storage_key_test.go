@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -125,3 +126,41 @@ func TestObjectStorageKey_ListByRegion(t *testing.T) {
 	assert.Len(t, filteredKeys, 2)
 	assert.Equal(t, "us-east-1", filteredKeys[0].Regions[0].ID)
 }
+
+func TestObjectStorageKey_CreateScoped(t *testing.T) {
+	client := createMockClient(t)
+
+	requestData := linodego.ObjectStorageKeyCreateOptions{
+		Label: "scoped-key",
+		BucketAccess: &[]linodego.ObjectStorageKeyBucketAccess{
+			{Region: "us-mia", BucketName: "logs", Permissions: "read_only"},
+			{Region: "us-mia", BucketName: "assets", Permissions: "read_only"},
+		},
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "object-storage/keys"),
+		mockRequestBodyValidate(t, requestData, map[string]any{"id": 1, "label": "scoped-key", "limited": true}))
+
+	access := (&linodego.ObjectStorageKeyAccess{}).
+		Grant("us-mia", "logs", linodego.ObjectStoragePermissionReadWrite).
+		Grant("us-mia", "assets", linodego.ObjectStoragePermissionReadOnly).
+		Grant("us-mia", "logs", linodego.ObjectStoragePermissionReadOnly)
+
+	key, err := client.CreateScopedObjectStorageKey(context.Background(), "scoped-key", access)
+	assert.NoError(t, err)
+	assert.True(t, key.Limited)
+}
+
+func TestObjectStorageKey_CreateScopedInvalid(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.CreateScopedObjectStorageKey(context.Background(), "scoped-key", nil)
+	assert.EqualError(t, err, "at least one bucket must be granted to create a limited key")
+
+	access := (&linodego.ObjectStorageKeyAccess{}).Grant("us-mia", "logs", "write_only")
+
+	_, err = client.CreateScopedObjectStorageKey(context.Background(), "scoped-key", access)
+	assert.EqualError(t, err, `invalid permission "write_only" for bucket us-mia/logs: must be read_only or read_write`)
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -156,6 +156,10 @@ func TestPlacementGroups_Assign(t *testing.T) {
 	assert.Equal(t, linodego.PlacementGroupPolicy("strict"), pg.PlacementGroupPolicy)
 	assert.Equal(t, linodego.PlacementGroupType("anti-affinity:local"), pg.PlacementGroupType)
 	assert.Equal(t, "us-mia", pg.Region)
+
+	assert.True(t, pg.HasMember(456))
+	assert.False(t, pg.HasMember(789))
+	assert.Empty(t, pg.NonCompliantMembers())
 }
 
 func TestPlacementGroups_Unassign(t *testing.T) {
@@ -184,3 +188,14 @@ func TestPlacementGroups_Unassign(t *testing.T) {
 	assert.Equal(t, linodego.PlacementGroupType("anti-affinity:local"), pg.PlacementGroupType)
 	assert.Equal(t, "us-mia", pg.Region)
 }
+
+func TestPlacementGroup_NonCompliantMembers(t *testing.T) {
+	pg := linodego.PlacementGroup{
+		Members: []linodego.PlacementGroupMember{
+			{LinodeID: 123, IsCompliant: true},
+			{LinodeID: 456, IsCompliant: false},
+		},
+	}
+
+	assert.Equal(t, []int{456}, pg.NonCompliantMembers())
+}
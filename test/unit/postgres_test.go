@@ -211,3 +211,33 @@ func TestDatabasePostgreSQL_Resume(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDatabasePostgres_MaintenanceWindowInvalid(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.UpdatePostgresDatabaseMaintenanceWindow(context.Background(), 123, linodego.DatabaseMaintenanceWindow{
+		DayOfWeek: 8,
+	})
+	assert.EqualError(t, err, "invalid maintenance window day_of_week 8: must be between 1 (Monday) and 7 (Sunday)")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestDatabasePostgres_AllowList(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("postgresql_database_get")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("databases/postgresql/instances/123", fixtureData)
+	base.MockPut("databases/postgresql/instances/123", map[string]any{"id": 123, "allow_list": []string{"203.0.113.1/32"}})
+
+	allowList, err := base.Client.GetPostgresDatabaseAllowList(context.Background(), 123)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"203.0.113.1/32", "192.0.1.0/24"}, allowList)
+
+	allowList, err = base.Client.RemovePostgresDatabaseAllowedIP(context.Background(), 123, "192.0.1.0/24")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"203.0.113.1/32"}, allowList)
+}
@@ -70,7 +70,7 @@ func TestProfileSSHKey_Create(t *testing.T) {
 
 	opts := linodego.SSHKeyCreateOptions{
 		Label:  "Test Key",
-		SSHKey: "ssh-rsa AAAAB3...",
+		SSHKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4f",
 	}
 
 	key, err := base.Client.CreateSSHKey(context.Background(), opts)
@@ -110,3 +110,25 @@ func TestProfileSSHKey_Delete(t *testing.T) {
 	err := base.Client.DeleteSSHKey(context.Background(), 123)
 	assert.NoError(t, err)
 }
+
+func TestProfileSSHKey_CreateInvalid(t *testing.T) {
+	client := createMockClient(t)
+
+	for _, key := range []string{
+		"",
+		"ssh-rsa",
+		"ssh-rsa not-base64!",
+		// authorized_keys options are not part of a public key
+		`from="10.0.0.0/8",no-pty ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4f user@host`,
+		// Only a single line is accepted, even if a later line is a valid key
+		"junk\nssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4f user@host",
+		// The declared type must match the encoded key
+		"ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4f user@host",
+	} {
+		_, err := client.CreateSSHKey(context.Background(), linodego.SSHKeyCreateOptions{Label: "bad", SSHKey: key})
+		assert.ErrorContains(t, err, "invalid SSH public key", key)
+	}
+
+	assert.NoError(t, linodego.ValidateSSHPublicKey("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4f user@host"))
+	assert.NoError(t, linodego.ValidateSSHPublicKey("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4f"))
+}
@@ -56,3 +56,12 @@ func TestTwoFactor_Confirm(t *testing.T) {
 
 	assert.Equal(t, "reallycoolandlegittfacode", response.Scratch)
 }
+
+func TestTwoFactor_ProvisioningURI(t *testing.T) {
+	secret := linodego.TwoFactorSecret{Secret: "5FXX6KLACOC33GTC"}
+
+	assert.Equal(t,
+		"otpauth://totp/Linode:example-user?issuer=Linode&secret=5FXX6KLACOC33GTC",
+		secret.ProvisioningURI("Linode", "example-user"),
+	)
+}
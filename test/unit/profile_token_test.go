@@ -104,3 +104,41 @@ func TestProfileToken_Delete(t *testing.T) {
 	err := base.Client.DeleteToken(context.Background(), 123)
 	assert.NoError(t, err)
 }
+
+func TestProfileToken_HasScope(t *testing.T) {
+	token := linodego.Token{Scopes: "linodes:read_write,domains:read_only events:read_only"}
+
+	assert.Equal(t, []string{"linodes:read_write", "domains:read_only", "events:read_only"}, token.ScopeList())
+
+	assert.True(t, token.HasScope("linodes:read_write"))
+	assert.True(t, token.HasScope("linodes:read_only"))
+	assert.True(t, token.HasScope("domains:read_only"))
+	assert.False(t, token.HasScope("domains:read_write"))
+	assert.True(t, token.HasScope("events"))
+	assert.False(t, token.HasScope("volumes:read_only"))
+
+	wildcard := linodego.Token{Scopes: "*"}
+	assert.True(t, wildcard.HasScope("account:read_write"))
+}
+
+func TestProfileToken_GetCurrent(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetToken("abcdef0123456789remainderofsecret")
+
+	base.MockGet("profile/tokens", map[string]any{
+		"page": 1, "pages": 1, "results": 2,
+		"data": []any{
+			map[string]any{"id": 1, "token": "zzzzzz0123456789", "scopes": "*"},
+			map[string]any{"id": 2, "token": "abcdef0123456789", "scopes": "linodes:read_only"},
+		},
+	})
+
+	token, err := base.Client.GetProfileToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, token.ID)
+	assert.True(t, token.HasScope("linodes:read_only"))
+	assert.False(t, token.HasScope("linodes:read_write"))
+}
@@ -3,8 +3,11 @@ package unit
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/slices"
@@ -120,3 +123,211 @@ func TestUpdateStackscript(t *testing.T) {
 	// Verify the updated stackscript's label
 	assert.Equal(t, "Updated Stackscript", updatedStackscript.Label, "Expected updated stackscript label to match input")
 }
+
+func TestStackscript_ValidateUDFResponses(t *testing.T) {
+	script := linodego.Stackscript{
+		UserDefinedFields: &[]linodego.StackscriptUDF{
+			{Name: "hostname"},
+			{Name: "size", OneOf: "small,medium,large"},
+			{Name: "features", ManyOf: "tls, metrics, logs"},
+			{Name: "port", Default: "8080"},
+		},
+	}
+
+	assert.NoError(t, script.ValidateUDFResponses(map[string]string{
+		"hostname": "web-1",
+		"size":     "medium",
+		"features": "tls,logs",
+	}))
+
+	err := script.ValidateUDFResponses(map[string]string{
+		"size":     "huge",
+		"features": "tls,tracing",
+	})
+	assert.ErrorContains(t, err, `UDF "hostname" is required`)
+	assert.ErrorContains(t, err, `UDF "size" must be one of small,medium,large, got "huge"`)
+	assert.ErrorContains(t, err, `UDF "features" values must be in tls, metrics, logs, got "tracing"`)
+	assert.NotContains(t, err.Error(), "port")
+}
+
+func TestStackscript_DiffScript(t *testing.T) {
+	current := linodego.Stackscript{ID: 10, Script: "#!/bin/bash\necho one\necho two\necho three\n"}
+	updated := linodego.Stackscript{ID: 10, Script: "#!/bin/bash\necho one\necho 2\necho three\n"}
+
+	expected := `--- stackscript/10
++++ stackscript/10
+@@ -1,4 +1,4 @@
+ #!/bin/bash
+ echo one
+-echo two
++echo 2
+ echo three
+`
+
+	assert.Equal(t, expected, current.DiffScript(updated))
+	assert.Empty(t, current.DiffScript(current))
+}
+
+func TestStackscript_DiffScriptHunks(t *testing.T) {
+	lines := func(changed ...int) string {
+		var sb strings.Builder
+		for i := 1; i <= 20; i++ {
+			if slices.Contains(changed, i) {
+				fmt.Fprintf(&sb, "changed %d\n", i)
+			} else {
+				fmt.Fprintf(&sb, "line %d\n", i)
+			}
+		}
+
+		return sb.String()
+	}
+
+	current := linodego.Stackscript{ID: 10, Script: lines()}
+
+	// Changes separated by six equal lines share a hunk
+	merged := linodego.Stackscript{ID: 11, Script: lines(5, 12)}
+
+	expected := `--- stackscript/10
++++ stackscript/11
+@@ -2,14 +2,14 @@
+ line 2
+ line 3
+ line 4
+-line 5
++changed 5
+ line 6
+ line 7
+ line 8
+ line 9
+ line 10
+ line 11
+-line 12
++changed 12
+ line 13
+ line 14
+ line 15
+`
+
+	assert.Equal(t, expected, current.DiffScript(merged))
+
+	// Changes separated by seven equal lines are in separate hunks
+	split := linodego.Stackscript{ID: 11, Script: lines(5, 13)}
+
+	expected = `--- stackscript/10
++++ stackscript/11
+@@ -2,7 +2,7 @@
+ line 2
+ line 3
+ line 4
+-line 5
++changed 5
+ line 6
+ line 7
+ line 8
+@@ -10,7 +10,7 @@
+ line 10
+ line 11
+ line 12
+-line 13
++changed 13
+ line 14
+ line 15
+ line 16
+`
+
+	assert.Equal(t, expected, current.DiffScript(split))
+}
+
+func TestStackscript_DiffScriptTrailingNewline(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		updated  string
+		expected string
+	}{
+		{
+			name:    "newline added",
+			current: "echo one\necho two",
+			updated: "echo one\necho two\n",
+			expected: `@@ -1,2 +1,2 @@
+ echo one
+-echo two
+\ No newline at end of file
++echo two
+`,
+		},
+		{
+			name:    "newline removed",
+			current: "echo one\necho two\n",
+			updated: "echo one\necho two",
+			expected: `@@ -1,2 +1,2 @@
+ echo one
+-echo two
++echo two
+\ No newline at end of file
+`,
+		},
+		{
+			name:    "no trailing newlines",
+			current: "echo one\necho two",
+			updated: "echo one\necho 2",
+			expected: `@@ -1,2 +1,2 @@
+ echo one
+-echo two
+\ No newline at end of file
++echo 2
+\ No newline at end of file
+`,
+		},
+		{
+			name:    "trailing newlines",
+			current: "echo one\necho two\n",
+			updated: "echo one\necho 2\n",
+			expected: `@@ -1,2 +1,2 @@
+ echo one
+-echo two
++echo 2
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			current := linodego.Stackscript{ID: 10, Script: tc.current}
+			updated := linodego.Stackscript{ID: 10, Script: tc.updated}
+
+			assert.Equal(t, "--- stackscript/10\n+++ stackscript/10\n"+tc.expected, current.DiffScript(updated))
+		})
+	}
+}
+
+func TestUpdateStackscript_Public(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("PUT", base.BaseURL+"linode/stackscripts/10",
+		httpmock.NewJsonResponderOrPanic(http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{{"field": "is_public", "reason": "Public StackScripts cannot be made private"}},
+		}))
+
+	_, err := base.Client.UpdateStackscript(context.Background(), 10, linodego.StackscriptUpdateOptions{IsPublic: false})
+	assert.ErrorIs(t, err, linodego.ErrStackscriptPublic)
+}
+
+func TestUpdateStackscript_OtherError(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("PUT", base.BaseURL+"linode/stackscripts/10",
+		httpmock.NewJsonResponderOrPanic(http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{{"field": "images", "reason": "Image linode/private is not public"}},
+		}))
+
+	_, err := base.Client.UpdateStackscript(context.Background(), 10, linodego.StackscriptUpdateOptions{
+		Images: []string{"linode/private"},
+	})
+	assert.True(t, linodego.ErrHasStatus(err, http.StatusBadRequest))
+	assert.NotErrorIs(t, err, linodego.ErrStackscriptPublic)
+}
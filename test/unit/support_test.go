@@ -2,8 +2,15 @@ package unit
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -70,3 +77,90 @@ func TestSupportTicket_Get(t *testing.T) {
 	assert.Equal(t, "Having trouble resetting root password on my Linode", ticket.Summary)
 	assert.Equal(t, "some_other_user", ticket.UpdatedBy)
 }
+
+func TestSupportTicket_UnmarshalClosable(t *testing.T) {
+	var ticket linodego.Ticket
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"id": 11223344, "closable": true}`), &ticket))
+	assert.True(t, ticket.Closeable)
+}
+
+func TestSupportTicket_Create(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("support_ticket_get")
+	assert.NoError(t, err)
+
+	client := createMockClient(t)
+
+	requestData := linodego.TicketCreateOptions{
+		Summary:     "Having trouble resetting root password on my Linode",
+		Description: "I am having trouble setting the root password on my Linode.",
+		LinodeID:    123456,
+	}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "support/tickets"),
+		mockRequestBodyValidate(t, requestData, fixtureData))
+
+	ticket, err := client.CreateTicket(context.Background(), requestData)
+	assert.NoError(t, err)
+	assert.Equal(t, 11223344, ticket.ID)
+	assert.Equal(t, time.Date(2015, time.June, 4, 14, 16, 44, 0, time.UTC), *ticket.Opened)
+}
+
+func TestSupportTicket_Replies(t *testing.T) {
+	fixtureData, err := fixtures.GetFixture("support_ticket_replies_list")
+	assert.NoError(t, err)
+
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("support/tickets/11223344/replies", fixtureData)
+
+	replies, err := base.Client.ListTicketReplies(context.Background(), 11223344, nil)
+	assert.NoError(t, err)
+	assert.Len(t, replies, 1)
+	assert.Equal(t, 11223345, replies[0].ID)
+	assert.True(t, replies[0].FromLinode)
+	assert.Equal(t, time.Date(2015, time.June, 2, 14, 31, 41, 0, time.UTC), *replies[0].Created)
+}
+
+func TestSupportTicket_CreateReply(t *testing.T) {
+	client := createMockClient(t)
+
+	requestData := linodego.TicketReplyCreateOptions{Description: "Thanks, that worked."}
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "support/tickets/11223344/replies"),
+		mockRequestBodyValidate(t, requestData, map[string]any{"id": 11223346, "description": "Thanks, that worked."}))
+
+	reply, err := client.CreateTicketReply(context.Background(), 11223344, requestData)
+	assert.NoError(t, err)
+	assert.Equal(t, 11223346, reply.ID)
+	assert.Equal(t, "Thanks, that worked.", reply.Description)
+}
+
+func TestSupportTicket_UploadAttachment(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	httpmock.RegisterResponder("POST", base.BaseURL+"support/tickets/11223344/attachments",
+		func(req *http.Request) (*http.Response, error) {
+			mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+			assert.NoError(t, err)
+			assert.Equal(t, "multipart/form-data", mediaType)
+
+			file, header, err := req.FormFile("file")
+			assert.NoError(t, err)
+			defer file.Close()
+
+			contents, err := io.ReadAll(file)
+			assert.NoError(t, err)
+			assert.Equal(t, "screenshot.txt", header.Filename)
+			assert.Equal(t, "console output", string(contents))
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{})
+		})
+
+	err := base.Client.UploadTicketAttachment(context.Background(), 11223344, "screenshot.txt", strings.NewReader("console output"))
+	assert.NoError(t, err)
+}
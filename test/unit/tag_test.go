@@ -3,9 +3,12 @@ package unit
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
+	"github.com/linode/linodego/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/slices"
 )
@@ -117,3 +120,49 @@ func TestSortedObjects(t *testing.T) {
 	assert.NotEmpty(t, sortedObjects.Instances, "Expected non-empty instances list in sorted objects")
 	assert.Equal(t, "example-instance", sortedObjects.Instances[0].Label, "Expected instance label to be 'example-instance'")
 }
+
+func TestApplyTag(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances/1", map[string]any{"id": 1, "tags": []string{"existing"}})
+	httpmock.RegisterResponder("PUT", base.BaseURL+"linode/instances/1",
+		testutil.MockRequestBodyValidate(t, linodego.InstanceUpdateOptions{
+			Tags: &[]string{"existing", "standard"},
+		}, map[string]any{"id": 1, "tags": []string{"existing", "standard"}}))
+
+	// Volume already has the tag and should not be updated
+	base.MockGet("volumes/2", map[string]any{"id": 2, "tags": []string{"standard"}})
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"domains/3",
+		httpmock.NewJsonResponderOrPanic(http.StatusNotFound, map[string]any{
+			"errors": []map[string]string{{"reason": "Not found"}},
+		}))
+
+	result, err := base.Client.ApplyTag(context.Background(), "standard", []linodego.TagTarget{
+		{Type: linodego.TaggedObjectVolume, ID: 2},
+		{Type: linodego.TaggedObjectLinode, ID: 1},
+		{Type: linodego.TaggedObjectDomain, ID: 3},
+		{Type: "stackscript", ID: 4},
+	})
+	assert.Error(t, err)
+	assert.True(t, linodego.IsNotFound(err))
+
+	assert.ElementsMatch(t, []linodego.TagTarget{
+		{Type: linodego.TaggedObjectLinode, ID: 1},
+		{Type: linodego.TaggedObjectVolume, ID: 2},
+	}, result.Succeeded)
+
+	assert.Len(t, result.Failed, 2)
+
+	failed := make(map[int]error)
+	for _, f := range result.Failed {
+		failed[f.Target.ID] = f.Err
+	}
+
+	assert.True(t, linodego.IsNotFound(failed[3]))
+	assert.ErrorContains(t, failed[4], "unsupported tag target type")
+
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["PUT "+base.BaseURL+"volumes/2"])
+}
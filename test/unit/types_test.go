@@ -73,3 +73,40 @@ func TestLinodeType_Get(t *testing.T) {
 		assert.NotNil(t, typeObj.Addons.Backups.Price, "Expected backups to have a price object")
 	}
 }
+
+func TestLinodeType_PriceForRegion(t *testing.T) {
+	linodeType := linodego.LinodeType{
+		ID:    "g6-standard-1",
+		Price: &linodego.LinodePrice{Hourly: 0.015, Monthly: 10},
+		RegionPrices: []linodego.LinodeRegionPrice{
+			{ID: "id-cgk", Hourly: 0.018, Monthly: 12},
+		},
+		Addons: &linodego.LinodeAddons{
+			Backups: &linodego.LinodeBackupsAddon{
+				Price: &linodego.LinodePrice{Hourly: 0.003, Monthly: 2},
+				RegionPrices: []linodego.LinodeRegionPrice{
+					{ID: "id-cgk", Hourly: 0.0036, Monthly: 2.4},
+				},
+			},
+		},
+	}
+
+	price, err := linodeType.PriceForRegion("id-cgk")
+	assert.NoError(t, err)
+	assert.Equal(t, &linodego.LinodePrice{Hourly: 0.018, Monthly: 12}, price)
+
+	price, err = linodeType.PriceForRegion("us-east")
+	assert.NoError(t, err)
+	assert.Equal(t, &linodego.LinodePrice{Hourly: 0.015, Monthly: 10}, price)
+
+	price, err = linodeType.BackupPriceForRegion("id-cgk")
+	assert.NoError(t, err)
+	assert.Equal(t, &linodego.LinodePrice{Hourly: 0.0036, Monthly: 2.4}, price)
+
+	price, err = linodeType.BackupPriceForRegion("us-east")
+	assert.NoError(t, err)
+	assert.Equal(t, &linodego.LinodePrice{Hourly: 0.003, Monthly: 2}, price)
+
+	_, err = linodego.LinodeType{ID: "g6-standard-1"}.BackupPriceForRegion("us-east")
+	assert.EqualError(t, err, `linode type "g6-standard-1" does not offer a backups addon`)
+}
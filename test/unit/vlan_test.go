@@ -62,3 +62,19 @@ func TestVLAN_GetIPAMAddress(t *testing.T) {
 	// Verify the returned IPAM address
 	assert.Equal(t, "10.0.0.1/24", ipamAddress, "Expected IPAM address to be '10.0.0.1/24'")
 }
+
+func TestVLAN_GetIPAMAddressNoConfigs(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("linode/instances/12345/configs", map[string]any{
+		"data":    []any{},
+		"page":    1,
+		"pages":   1,
+		"results": 0,
+	})
+
+	_, err := base.Client.GetVLANIPAMAddress(context.Background(), 12345, "test-vlan")
+	assert.EqualError(t, err, "Failed to find IPAMAddress for VLAN: test-vlan")
+}
@@ -2,9 +2,13 @@ package unit
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -177,3 +181,193 @@ func TestResizeVolume(t *testing.T) {
 	err := base.Client.ResizeVolume(context.Background(), volumeID, 50)
 	assert.NoError(t, err, "Expected no error when resizing volume")
 }
+
+func TestCloneVolumeAndWait(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	base.MockEventFeed(map[string]any{
+		"id":     400,
+		"action": "volume_clone",
+		"entity": map[string]any{"id": 123, "type": "volume"},
+	}, "POST", "volumes/123/clone", httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{"id": 456, "label": "cloned", "status": "creating"}))
+	base.MockGet("volumes/456", map[string]any{"id": 456, "label": "cloned", "status": "active"})
+
+	volume, err := base.Client.CloneVolumeAndWait(context.Background(), 123, "cloned", 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 456, volume.ID)
+	assert.Equal(t, linodego.VolumeActive, volume.Status)
+}
+
+func TestResizeVolumeAndWait(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	resized := base.MockEventFeed(map[string]any{
+		"id":     400,
+		"action": "volume_resize",
+		"entity": map[string]any{"id": 123, "type": "volume"},
+	}, "POST", "volumes/123/resize", httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{}))
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"volumes/123",
+		func(req *http.Request) (*http.Response, error) {
+			size := 20
+			if resized() {
+				size = 50
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": 123, "size": size, "status": "active"})
+		})
+
+	volume, err := base.Client.ResizeVolumeAndWait(context.Background(), 123, 50, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, volume.Size)
+}
+
+func TestResizeVolumeAndWait_Shrink(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("volumes/123", map[string]any{"id": 123, "size": 20, "status": "active"})
+
+	_, err := base.Client.ResizeVolumeAndWait(context.Background(), 123, 10, 5)
+	assert.EqualError(t, err, "cannot resize volume 123 from 20GB to 10GB: volumes can only be resized to a larger size")
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"volumes/123/resize"])
+}
+
+func TestResizeVolumeAndWait_SameSize(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("volumes/123", map[string]any{"id": 123, "size": 20, "status": "active"})
+
+	_, err := base.Client.ResizeVolumeAndWait(context.Background(), 123, 20, 5)
+	assert.EqualError(t, err, "cannot resize volume 123 from 20GB to 20GB: volumes can only be resized to a larger size")
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+base.BaseURL+"volumes/123/resize"])
+}
+
+func TestEnsureVolumeAttached_AlreadyAttached(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("volumes/123", map[string]any{"id": 123, "linode_id": 456})
+
+	volume, err := base.Client.EnsureVolumeAttached(context.Background(), 123, 456, linodego.EnsureVolumeAttachedOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 456, *volume.LinodeID)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestEnsureVolumeAttached_AttachedElsewhere(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("volumes/123", map[string]any{"id": 123, "linode_id": 789})
+
+	_, err := base.Client.EnsureVolumeAttached(context.Background(), 123, 456, linodego.EnsureVolumeAttachedOptions{})
+	assert.ErrorIs(t, err, linodego.ErrVolumeAttachedElsewhere)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestEnsureVolumeAttached_DetachFromOther(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.Client.SetPollDelay(time.Millisecond)
+
+	linodeID := any(789)
+	var events []any
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"account/events",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"page": 1, "pages": 1, "results": len(events), "data": events})
+		})
+
+	httpmock.RegisterResponder("GET", base.BaseURL+"volumes/123",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": 123, "linode_id": linodeID})
+		})
+
+	httpmock.RegisterResponder("POST", base.BaseURL+"volumes/123/detach",
+		func(req *http.Request) (*http.Response, error) {
+			linodeID = nil
+			events = append(events, map[string]any{
+				"id": 400, "action": "volume_detach", "status": "started",
+				"entity": map[string]any{"id": 123, "type": "volume"},
+			})
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{})
+		})
+
+	httpmock.RegisterResponder("POST", base.BaseURL+"volumes/123/attach",
+		func(req *http.Request) (*http.Response, error) {
+			var opts linodego.VolumeAttachOptions
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&opts))
+			assert.Equal(t, linodego.VolumeAttachOptions{LinodeID: 456, ConfigID: 10}, opts)
+
+			linodeID = 456
+			events = append(events, map[string]any{
+				"id": 401, "action": "volume_attach", "status": "started",
+				"entity": map[string]any{"id": 123, "type": "volume"},
+			})
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"id": 123, "linode_id": 456})
+		})
+
+	base.MockGet("account/events/400", map[string]any{"id": 400, "action": "volume_detach", "status": "finished"})
+	base.MockGet("account/events/401", map[string]any{"id": 401, "action": "volume_attach", "status": "finished"})
+
+	volume, err := base.Client.EnsureVolumeAttached(context.Background(), 123, 456, linodego.EnsureVolumeAttachedOptions{
+		ConfigID:        10,
+		DetachFromOther: true,
+		TimeoutSeconds:  5,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 456, *volume.LinodeID)
+}
+
+func TestEnsureVolumeDetached_AlreadyDetached(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	base.MockGet("volumes/123", map[string]any{"id": 123, "linode_id": nil})
+
+	volume, err := base.Client.EnsureVolumeDetached(context.Background(), 123, 5)
+	assert.NoError(t, err)
+	assert.Nil(t, volume.LinodeID)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestVolumeCreateOptions_Validate(t *testing.T) {
+	assert.NoError(t, linodego.VolumeCreateOptions{Region: "us-east", Size: 20}.Validate())
+	assert.NoError(t, linodego.VolumeCreateOptions{LinodeID: 123, ConfigID: 456}.Validate())
+
+	assert.NoError(t, linodego.VolumeCreateOptions{Region: "us-east", Size: 5}.Validate())
+
+	err := linodego.VolumeCreateOptions{ConfigID: 456, Encryption: "yes"}.Validate()
+	assert.EqualError(t, err, `either region or linode_id is required
+linode_id is required when config_id is set
+unknown encryption "yes"`)
+}
+
+func TestVolumeCreateOptions_Clone(t *testing.T) {
+	base := linodego.VolumeCreateOptions{Region: "us-east", Tags: []string{"base"}, PersistAcrossBoots: linodego.Pointer(true)}
+
+	clone := base.Clone()
+	assert.Equal(t, base, clone)
+
+	clone.Tags[0] = "changed"
+	*clone.PersistAcrossBoots = false
+
+	assert.Equal(t, "base", base.Tags[0])
+	assert.True(t, *base.PersistAcrossBoots)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
 )
@@ -99,3 +100,19 @@ func TestVPCSubnet_Delete(t *testing.T) {
 	err := base.Client.DeleteVPCSubnet(context.Background(), 123, 456)
 	assert.NoError(t, err, "Expected no error when deleting VPCSubnet")
 }
+
+func TestVPCSubnet_CreateInvalidIPv4(t *testing.T) {
+	var base ClientBaseCase
+	base.SetUp(t)
+	defer base.TearDown(t)
+
+	for _, ipv4 := range []string{"192.168.1.0", "192.168.1.0/33", "not-a-cidr", "2001:db8::/64"} {
+		_, err := base.Client.CreateVPCSubnet(context.Background(), linodego.VPCSubnetCreateOptions{
+			Label: "Test Subnet",
+			IPv4:  ipv4,
+		}, 123)
+		assert.ErrorContains(t, err, "invalid subnet IPv4 range", "expected %q to be rejected", ipv4)
+	}
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount(), "expected no requests to be made")
+}
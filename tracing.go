@@ -0,0 +1,128 @@
+package linodego
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const tracerInstrumentationName = "github.com/linode/linodego"
+
+// TracerProvider provides Tracers used to trace API requests.
+// It mirrors the subset of the OpenTelemetry trace.TracerProvider API used by
+// linodego, so that linodego does not depend on OpenTelemetry directly.
+// OpenTelemetry users can satisfy it with a small adapter around their
+// trace.TracerProvider.
+type TracerProvider interface {
+	Tracer(instrumentationName string) Tracer
+}
+
+// Tracer starts Spans for API requests.
+type Tracer interface {
+	// Start creates a Span and returns a context containing it.
+	// The returned context is used for the outgoing request, so trace
+	// context propagates to any downstream HTTP transport.
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span represents a single traced API request.
+type Span interface {
+	SetAttribute(key string, value any)
+	RecordError(err error)
+	End()
+}
+
+// routeIDCollections maps the collections whose members are identified by strings rather
+// than numeric IDs to the number of ID segments that follow them in a request path.
+var routeIDCollections = map[string]int{
+	"buckets":  2,
+	"kernels":  1,
+	"regions":  1,
+	"tiers":    1,
+	"types":    1,
+	"versions": 1,
+}
+
+// routeTemplate replaces the IDs in an escaped API request path with {id} placeholders, e.g.
+// /v4/linode/instances/123/disks/456 becomes /v4/linode/instances/{id}/disks/{id}, so that
+// span names stay the same across requests for different resources.
+func routeTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	pending := 0
+
+	for i, segment := range segments {
+		switch {
+		case pending > 0 && segment != "availability":
+			segments[i] = "{id}"
+			pending--
+		case isRouteID(segment):
+			segments[i] = "{id}"
+		default:
+			pending = routeIDCollections[segment]
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// isRouteID reports whether a path segment is a numeric ID, an IP address or an escaped
+// string ID such as an image or kernel ID.
+func isRouteID(segment string) bool {
+	if segment == "" {
+		return false
+	}
+
+	return strings.Trim(segment, "0123456789") == "" ||
+		strings.Contains(segment, "%") ||
+		net.ParseIP(segment) != nil
+}
+
+// tracingTransport wraps an http.RoundTripper and records a Span for each
+// request sent to the API, including retries.
+type tracingTransport struct {
+	base   http.RoundTripper
+	tracer Tracer
+}
+
+func (t *tracingTransport) baseTransport() http.RoundTripper {
+	return t.base
+}
+
+func (t *tracingTransport) withBaseTransport(base http.RoundTripper) http.RoundTripper {
+	return &tracingTransport{base: base, tracer: t.tracer}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	route := routeTemplate(req.URL.EscapedPath())
+
+	ctx, span := t.tracer.Start(req.Context(), req.Method+" "+route)
+	defer span.End()
+
+	span.SetAttribute("http.request.method", req.Method)
+	span.SetAttribute("http.route", route)
+	span.SetAttribute("url.path", req.URL.Path)
+	span.SetAttribute("url.full", req.URL.String())
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		return resp, err
+	}
+
+	span.SetAttribute("http.response.status_code", resp.StatusCode)
+
+	if requestID := resp.Header.Get(requestIDHeaderName); requestID != "" {
+		span.SetAttribute("linode.request_id", requestID)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.RecordError(Error{
+			Code:      resp.StatusCode,
+			Message:   http.StatusText(resp.StatusCode),
+			RequestID: resp.Header.Get(requestIDHeaderName),
+		})
+	}
+
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 )
 
@@ -48,6 +49,37 @@ type LinodeRegionPrice struct {
 	Monthly float32 `json:"monthly"`
 }
 
+// PriceForRegion returns the price of this type in the given region. The region's
+// price override is returned if one exists, otherwise the base price is returned.
+func (t LinodeType) PriceForRegion(region string) (*LinodePrice, error) {
+	return resolveRegionPrice(t.Price, t.RegionPrices, region)
+}
+
+// BackupPriceForRegion returns the price of the backups addon for this type in the given
+// region. The region's price override is returned if one exists, otherwise the base
+// backups price is returned.
+func (t LinodeType) BackupPriceForRegion(region string) (*LinodePrice, error) {
+	if t.Addons == nil || t.Addons.Backups == nil {
+		return nil, fmt.Errorf("linode type %q does not offer a backups addon", t.ID)
+	}
+
+	return resolveRegionPrice(t.Addons.Backups.Price, t.Addons.Backups.RegionPrices, region)
+}
+
+func resolveRegionPrice(base *LinodePrice, regionPrices []LinodeRegionPrice, region string) (*LinodePrice, error) {
+	for _, p := range regionPrices {
+		if p.ID == region {
+			return &LinodePrice{Hourly: p.Hourly, Monthly: p.Monthly}, nil
+		}
+	}
+
+	if base == nil {
+		return nil, fmt.Errorf("no price available for region %q", region)
+	}
+
+	return &LinodePrice{Hourly: base.Hourly, Monthly: base.Monthly}, nil
+}
+
 // LinodeTypeClass constants start with Class and include Linode API Instance Type Classes
 type LinodeTypeClass string
 
@@ -69,7 +101,7 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
 		return result.([]LinodeType), nil
 	}
 
@@ -78,7 +110,7 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -87,7 +119,7 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error) {
 	e := formatAPIPath("linode/types/%s", url.PathEscape(typeID))
 
-	if result := c.getCachedResponse(e); result != nil {
+	if result := c.getCachedResponse(ctx, e); result != nil {
 		result := result.(LinodeType)
 		return &result, nil
 	}
@@ -97,7 +129,7 @@ func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error
 		return nil, err
 	}
 
-	c.addCachedResponse(e, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, e, response, &cacheExpiryTime)
 
 	return response, nil
 }
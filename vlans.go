@@ -9,6 +9,7 @@ import (
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// VLAN represents a Virtual LAN and the Linodes attached to it
 type VLAN struct {
 	Label   string     `json:"label"`
 	Linodes []int      `json:"linodes"`
@@ -40,7 +41,8 @@ func (c *Client) ListVLANs(ctx context.Context, opts *ListOptions) ([]VLAN, erro
 	return getPaginatedResults[VLAN](ctx, c, "networking/vlans", opts)
 }
 
-// GetVLANIPAMAddress returns the IPAM Address for a given VLAN Label as a string (10.0.0.1/24)
+// GetVLANIPAMAddress returns the IPAM Address for a given VLAN Label as a string (10.0.0.1/24).
+// All of the Linode's configs are searched for a VLAN interface with the given label.
 func (c *Client) GetVLANIPAMAddress(ctx context.Context, linodeID int, vlanLabel string) (string, error) {
 	f := Filter{}
 	f.AddField(Eq, "interfaces", vlanLabel)
@@ -54,10 +56,11 @@ func (c *Client) GetVLANIPAMAddress(ctx context.Context, linodeID int, vlanLabel
 		return "", fmt.Errorf("Fetching configs for instance %v failed: %w", linodeID, err)
 	}
 
-	interfaces := cfgs[0].Interfaces
-	for _, face := range interfaces {
-		if face.Label == vlanLabel {
-			return face.IPAMAddress, nil
+	for _, cfg := range cfgs {
+		for _, face := range cfg.Interfaces {
+			if face.Purpose == InterfacePurposeVLAN && face.Label == vlanLabel {
+				return face.IPAMAddress, nil
+			}
 		}
 	}
 
@@ -3,11 +3,18 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// ErrVolumeAttachedElsewhere is returned by EnsureVolumeAttached when the Volume is
+// attached to a different Linode and DetachFromOther is not set.
+var ErrVolumeAttachedElsewhere = errors.New("volume is attached to another Linode")
+
 // VolumeStatus indicates the status of the Volume
 type VolumeStatus string
 
@@ -58,6 +65,36 @@ type VolumeCreateOptions struct {
 	Encryption         string   `json:"encryption,omitempty"`
 }
 
+// Validate reports a missing region or Linode and unknown encryption values.
+// Size limits are left for the API to enforce.
+func (o VolumeCreateOptions) Validate() error {
+	var errs []error
+
+	if o.Region == "" && o.LinodeID == 0 {
+		errs = append(errs, errors.New("either region or linode_id is required"))
+	}
+
+	if o.ConfigID != 0 && o.LinodeID == 0 {
+		errs = append(errs, errors.New("linode_id is required when config_id is set"))
+	}
+
+	switch o.Encryption {
+	case "", "enabled", "disabled":
+	default:
+		errs = append(errs, fmt.Errorf("unknown encryption %q", o.Encryption))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Clone returns a deep copy of the VolumeCreateOptions.
+func (o VolumeCreateOptions) Clone() VolumeCreateOptions {
+	o.Tags = slices.Clone(o.Tags)
+	o.PersistAcrossBoots = copyBool(o.PersistAcrossBoots)
+
+	return o
+}
+
 // VolumeUpdateOptions fields are those accepted by UpdateVolume
 type VolumeUpdateOptions struct {
 	Label string    `json:"label,omitempty"`
@@ -71,6 +108,19 @@ type VolumeAttachOptions struct {
 	PersistAcrossBoots *bool `json:"persist_across_boots,omitempty"`
 }
 
+// EnsureVolumeAttachedOptions fields are those accepted by EnsureVolumeAttached
+type EnsureVolumeAttachedOptions struct {
+	ConfigID           int
+	PersistAcrossBoots *bool
+
+	// If true, the Volume is detached from any other Linode it is attached to
+	// before being attached to the target Linode.
+	DetachFromOther bool
+
+	// TimeoutSeconds limits how long to wait for each detach and attach event.
+	TimeoutSeconds int
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (v *Volume) UnmarshalJSON(b []byte) error {
 	type Mask Volume
@@ -129,6 +179,80 @@ func (c *Client) AttachVolume(ctx context.Context, volumeID int, opts *VolumeAtt
 	return doPOSTRequest[Volume](ctx, c, e, opts)
 }
 
+// EnsureVolumeAttached ensures the Volume is attached to the given Linode and waits
+// for the volume_attach event to finish, returning the attached Volume.
+// No changes are made if the Volume is already attached to the Linode. If the Volume
+// is attached to another Linode, it is detached first when opts.DetachFromOther is set;
+// otherwise ErrVolumeAttachedElsewhere is returned.
+func (c *Client) EnsureVolumeAttached(ctx context.Context, volumeID, linodeID int, opts EnsureVolumeAttachedOptions) (*Volume, error) {
+	volume, err := c.GetVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if volume.LinodeID != nil {
+		if *volume.LinodeID == linodeID {
+			return volume, nil
+		}
+
+		if !opts.DetachFromOther {
+			return nil, fmt.Errorf("%w: volume %d is attached to linode %d", ErrVolumeAttachedElsewhere, volumeID, *volume.LinodeID)
+		}
+
+		if _, err := c.EnsureVolumeDetached(ctx, volumeID, opts.TimeoutSeconds); err != nil {
+			return nil, err
+		}
+	}
+
+	poller, err := c.NewEventPoller(ctx, volumeID, EntityVolume, ActionVolumeAttach)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.AttachVolume(ctx, volumeID, &VolumeAttachOptions{
+		LinodeID:           linodeID,
+		ConfigID:           opts.ConfigID,
+		PersistAcrossBoots: opts.PersistAcrossBoots,
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, opts.TimeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for volume %d to be attached to linode %d: %w", volumeID, linodeID, err)
+	}
+
+	return c.GetVolume(ctx, volumeID)
+}
+
+// EnsureVolumeDetached ensures the Volume is not attached to any Linode and waits
+// for the volume_detach event to finish, returning the detached Volume.
+// No changes are made if the Volume is already detached.
+func (c *Client) EnsureVolumeDetached(ctx context.Context, volumeID int, timeoutSeconds int) (*Volume, error) {
+	volume, err := c.GetVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if volume.LinodeID == nil {
+		return volume, nil
+	}
+
+	poller, err := c.NewEventPoller(ctx, volumeID, EntityVolume, ActionVolumeDetach)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.DetachVolume(ctx, volumeID); err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for volume %d to be detached from linode %d: %w", volumeID, *volume.LinodeID, err)
+	}
+
+	return c.GetVolume(ctx, volumeID)
+}
+
 // CreateVolume creates a Linode Volume
 func (c *Client) CreateVolume(ctx context.Context, opts VolumeCreateOptions) (*Volume, error) {
 	return doPOSTRequest[Volume](ctx, c, "volumes", opts)
@@ -150,6 +274,26 @@ func (c *Client) CloneVolume(ctx context.Context, volumeID int, label string) (*
 	return doPOSTRequest[Volume](ctx, c, e, opts)
 }
 
+// CloneVolumeAndWait clones a Linode volume and waits for the volume_clone event
+// to finish, returning the new Volume. It will timeout with an error after timeoutSeconds.
+func (c *Client) CloneVolumeAndWait(ctx context.Context, volumeID int, label string, timeoutSeconds int) (*Volume, error) {
+	poller, err := c.NewEventPoller(ctx, volumeID, EntityVolume, ActionVolumeClone)
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := c.CloneVolume(ctx, volumeID, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for volume %d to be cloned to %d: %w", volumeID, clone.ID, err)
+	}
+
+	return c.GetVolume(ctx, clone.ID)
+}
+
 // DetachVolume detaches a Linode volume
 func (c *Client) DetachVolume(ctx context.Context, volumeID int) error {
 	e := formatAPIPath("volumes/%d/detach", volumeID)
@@ -166,6 +310,39 @@ func (c *Client) ResizeVolume(ctx context.Context, volumeID int, size int) error
 	return doPOSTRequestNoResponseBody(ctx, c, e, opts)
 }
 
+// ResizeVolumeAndWait resizes a Linode volume to the given size in GiB and waits for
+// the volume_resize event to finish, returning the resized Volume.
+// Volumes can only be grown, so a size that is not larger than the Volume's current size
+// is rejected before any resize is requested. It will timeout with an error after timeoutSeconds.
+func (c *Client) ResizeVolumeAndWait(ctx context.Context, volumeID int, size int, timeoutSeconds int) (*Volume, error) {
+	volume, err := c.GetVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= volume.Size {
+		return nil, fmt.Errorf(
+			"cannot resize volume %d from %dGB to %dGB: volumes can only be resized to a larger size",
+			volumeID, volume.Size, size,
+		)
+	}
+
+	poller, err := c.NewEventPoller(ctx, volumeID, EntityVolume, ActionVolumeResize)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.ResizeVolume(ctx, volumeID, size); err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for volume %d to be resized: %w", volumeID, err)
+	}
+
+	return c.GetVolume(ctx, volumeID)
+}
+
 // DeleteVolume deletes the Volume with the specified id
 func (c *Client) DeleteVolume(ctx context.Context, volumeID int) error {
 	e := formatAPIPath("volumes/%d", volumeID)
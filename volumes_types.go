@@ -30,7 +30,7 @@ func (c *Client) ListVolumeTypes(ctx context.Context, opts *ListOptions) ([]Volu
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedResponse(ctx, endpoint); result != nil {
 		return result.([]VolumeType), nil
 	}
 
@@ -39,7 +39,7 @@ func (c *Client) ListVolumeTypes(ctx context.Context, opts *ListOptions) ([]Volu
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
+	c.addCachedResponse(ctx, endpoint, response, &cacheExpiryTime)
 
 	return response, nil
 }
@@ -3,6 +3,8 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/netip"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -69,11 +71,17 @@ func (v VPCSubnet) GetUpdateOptions() VPCSubnetUpdateOptions {
 	return VPCSubnetUpdateOptions{Label: v.Label}
 }
 
+// CreateVPCSubnet creates a subnet in the given VPC.
+// The IPv4 range of opts is validated client-side before sending the request.
 func (c *Client) CreateVPCSubnet(
 	ctx context.Context,
 	opts VPCSubnetCreateOptions,
 	vpcID int,
 ) (*VPCSubnet, error) {
+	if err := validateSubnetIPv4(opts.IPv4); err != nil {
+		return nil, err
+	}
+
 	e := formatAPIPath("vpcs/%d/subnets", vpcID)
 	return doPOSTRequest[VPCSubnet](ctx, c, e, opts)
 }
@@ -109,3 +117,22 @@ func (c *Client) DeleteVPCSubnet(ctx context.Context, vpcID int, subnetID int) e
 	e := formatAPIPath("vpcs/%d/subnets/%d", vpcID, subnetID)
 	return doDELETERequest(ctx, c, e)
 }
+
+// validateSubnetIPv4 rejects malformed IPv4 CIDR ranges.
+// An empty range is allowed so the API can report it as a missing field.
+func validateSubnetIPv4(ipv4 string) error {
+	if ipv4 == "" {
+		return nil
+	}
+
+	prefix, err := netip.ParsePrefix(ipv4)
+	if err != nil {
+		return fmt.Errorf("invalid subnet IPv4 range %q: %w", ipv4, err)
+	}
+
+	if !prefix.Addr().Is4() {
+		return fmt.Errorf("invalid subnet IPv4 range %q: not an IPv4 range", ipv4)
+	}
+
+	return nil
+}
@@ -15,6 +15,42 @@ import (
 
 var englishTitle = cases.Title(language.English)
 
+// DefaultWaitPollInterval is the poll interval used by WaitOptions
+// when no PollInterval is specified.
+const DefaultWaitPollInterval = 5 * time.Second
+
+// WaitOptions configures polls performed by WaitFor*WithOptions functions.
+type WaitOptions struct {
+	// PollInterval is the time to wait between polls.
+	// Defaults to DefaultWaitPollInterval if zero.
+	PollInterval time.Duration
+
+	// Timeout is an optional duration to wait before exiting,
+	// applied on top of any deadline of the provided context.
+	Timeout time.Duration
+}
+
+func (o WaitOptions) pollInterval() time.Duration {
+	if o.PollInterval <= 0 {
+		return DefaultWaitPollInterval
+	}
+
+	return o.PollInterval
+}
+
+func (o WaitOptions) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o.Timeout > 0 {
+		return context.WithTimeout(ctx, o.Timeout)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// EventPoller waits for a single event matching an entity and action. Events that
+// already exist when the poller is created (see NewEventPoller and PreTask) are
+// recorded and ignored, as is any event the poller has already returned, so only
+// events triggered after the poller was constructed are considered.
+// The polling frequency is controlled by the client's poll delay (see SetPollDelay).
 type EventPoller struct {
 	EntityID   any
 	EntityType EntityType
@@ -56,6 +92,51 @@ func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int,
 	}
 }
 
+// WaitForInstanceStatusWithOptions waits for the Linode instance to reach the desired state
+// before returning, polling according to the given WaitOptions. On timeout, the returned
+// error includes the last observed status of the instance.
+func (client Client) WaitForInstanceStatusWithOptions(
+	ctx context.Context, instanceID int, status InstanceStatus, opts WaitOptions,
+) (*Instance, error) {
+	ctx, cancel := opts.withTimeout(ctx)
+	defer cancel()
+
+	ticker := time.NewTicker(opts.pollInterval())
+	defer ticker.Stop()
+
+	var lastStatus InstanceStatus
+
+	timeoutError := func() error {
+		return fmt.Errorf(
+			"Error waiting for Instance %d status %s (last observed status: %q): %w",
+			instanceID, status, lastStatus, ctx.Err(),
+		)
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			instance, err := client.GetInstance(ctx, instanceID)
+			if err != nil {
+				// The request may have been interrupted by the deadline itself
+				if ctx.Err() != nil {
+					return nil, timeoutError()
+				}
+
+				return nil, err
+			}
+
+			lastStatus = instance.Status
+
+			if instance.Status == status {
+				return instance, nil
+			}
+		case <-ctx.Done():
+			return nil, timeoutError()
+		}
+	}
+}
+
 // WaitForInstanceDiskStatus waits for the Linode instance disk to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
 func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus, timeoutSeconds int) (*InstanceDisk, error) {
@@ -427,7 +508,7 @@ func (client Client) WaitForImageStatus(ctx context.Context, imageID string, sta
 	for {
 		select {
 		case <-ticker.C:
-			image, err := client.GetImage(ctx, imageID)
+			image, err := client.getImage(ctx, imageID)
 			if err != nil {
 				return image, err
 			}
@@ -451,7 +532,7 @@ func (client Client) WaitForImageRegionStatus(ctx context.Context, imageID, regi
 	for {
 		select {
 		case <-ticker.C:
-			image, err := client.GetImage(ctx, imageID)
+			image, err := client.getImage(ctx, imageID)
 			if err != nil {
 				return image, err
 			}
@@ -676,6 +757,8 @@ func (p *EventPoller) PreTask(ctx context.Context) error {
 	return nil
 }
 
+// WaitForLatestUnknownEvent polls until an event matching the poller that was not
+// seen during PreTask (or returned by a previous call) appears, and returns it.
 func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, error) {
 	ticker := time.NewTicker(p.client.pollInterval)
 	defer ticker.Stop()
@@ -752,6 +835,10 @@ func (p *EventPoller) WaitForFinished(
 			case EventFinished:
 				return event, nil
 			case EventFailed:
+				if event.Message != "" {
+					return nil, fmt.Errorf("event %d has failed: %s", event.ID, event.Message)
+				}
+
 				return nil, fmt.Errorf("event %d has failed", event.ID)
 			case EventScheduled, EventStarted, EventNotification:
 				continue
@@ -815,6 +902,70 @@ func (client Client) WaitForResourceFree(
 	}
 }
 
+// WaitForResourceFreeWithOptions waits for a resource to have no started or scheduled events,
+// polling according to the given WaitOptions. If the wait is cancelled or times out, the events
+// that were still pending on the last poll are returned alongside the error.
+func (client Client) WaitForResourceFreeWithOptions(
+	ctx context.Context, entityType EntityType, entityID any, opts WaitOptions,
+) ([]Event, error) {
+	apiFilter := Filter{
+		Order:   Descending,
+		OrderBy: "created",
+	}
+	apiFilter.AddField(Eq, "entity.id", entityID)
+	apiFilter.AddField(Eq, "entity.type", entityType)
+
+	filterStr, err := apiFilter.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create filter: %w", err)
+	}
+
+	ctx, cancel := opts.withTimeout(ctx)
+	defer cancel()
+
+	ticker := time.NewTicker(opts.pollInterval())
+	defer ticker.Stop()
+
+	var pending []Event
+
+	timeoutError := func() error {
+		return fmt.Errorf(
+			"failed to wait for %s %v to be free (%d events pending): %w",
+			entityType, entityID, len(pending), ctx.Err(),
+		)
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			events, err := client.ListEvents(ctx, &ListOptions{
+				Filter: string(filterStr),
+			})
+			if err != nil {
+				// The request may have been interrupted by the deadline itself
+				if ctx.Err() != nil {
+					return pending, timeoutError()
+				}
+
+				return nil, fmt.Errorf("failed to list events: %w", err)
+			}
+
+			pending = pending[:0]
+			for _, event := range events {
+				if event.Status == EventStarted || event.Status == EventScheduled {
+					pending = append(pending, event)
+				}
+			}
+
+			if len(pending) == 0 {
+				return nil, nil
+			}
+		case <-ctx.Done():
+			return pending, timeoutError()
+		}
+	}
+}
+
 // eventMatchesSecondary returns whether the given event's secondary entity
 // matches the configured secondary ID.
 // This logic has been broken out to improve readability.